@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Certificate types.
+const (
+	CertificateTypeUploaded = "uploaded"
+	CertificateTypeManaged  = "managed"
+)
+
+// CertificateParameters are the configurable fields of a Certificate.
+// Certificates are immutable, changing anything but the labels requires
+// recreating them. Such changes are reported by the CertificateDrift
+// condition.
+type CertificateParameters struct {
+	// +kubebuilder:validation:Enum=uploaded;managed
+	// +kubebuilder:default=uploaded
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// CertificateSecretRef points to the PEM encoded certificate, including
+	// the chain, of an uploaded Certificate.
+	// +optional
+	CertificateSecretRef *xpv1.SecretKeySelector `json:"certificateSecretRef,omitempty"`
+
+	// PrivateKeySecretRef points to the PEM encoded private key of an
+	// uploaded Certificate.
+	// +optional
+	PrivateKeySecretRef *xpv1.SecretKeySelector `json:"privateKeySecretRef,omitempty"`
+
+	// DomainNames the managed Certificate is issued for.
+	// +optional
+	DomainNames []string `json:"domainNames,omitempty"`
+
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// CertificateObservation are the observable fields of a Certificate.
+type CertificateObservation struct {
+	Id             int          `json:"id"`
+	Created        *metav1.Time `json:"created,omitempty"`
+	NotValidBefore *metav1.Time `json:"notValidBefore,omitempty"`
+	NotValidAfter  *metav1.Time `json:"notValidAfter,omitempty"`
+	DomainNames    []string     `json:"domainNames,omitempty"`
+	Fingerprint    string       `json:"fingerprint,omitempty"`
+
+	// Issuance and Renewal report the progress of a managed Certificate.
+	Issuance string `json:"issuance,omitempty"`
+	Renewal  string `json:"renewal,omitempty"`
+
+	// Error is the last issuance or renewal error reported by Hetzner.
+	Error string `json:"error,omitempty"`
+
+	// LastIssuanceRetry is when the failed issuance of a managed Certificate
+	// was last retried.
+	// +optional
+	LastIssuanceRetry *metav1.Time `json:"lastIssuanceRetry,omitempty"`
+}
+
+// A CertificateSpec defines the desired state of a Certificate.
+type CertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CertificateParameters `json:"forProvider"`
+}
+
+// A CertificateStatus represents the observed state of a Certificate.
+type CertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Certificate is a TLS certificate used by LoadBalancers, either uploaded
+// or issued and renewed by Hetzner.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name",priority=1
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="ISSUANCE",type="string",JSONPath=".status.atProvider.issuance"
+// +kubebuilder:printcolumn:name="NOT-AFTER",type="date",JSONPath=".status.atProvider.notValidAfter",priority=10
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateSpec   `json:"spec"`
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateList contains a list of Certificate
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Certificate `json:"items"`
+}
+
+// Certificate type metadata.
+var (
+	CertificateKind             = reflect.TypeOf(Certificate{}).Name()
+	CertificateGroupKind        = schema.GroupKind{Group: Group, Kind: CertificateKind}.String()
+	CertificateKindAPIVersion   = CertificateKind + "." + SchemeGroupVersion.String()
+	CertificateGroupVersionKind = SchemeGroupVersion.WithKind(CertificateKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Certificate{}, &CertificateList{})
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition types of a Server.
+const (
+	// TypeServerTypeDrift indicates whether the Server type differs from the
+	// spec without the Server being allowed to rescale.
+	TypeServerTypeDrift xpv1.ConditionType = "ServerTypeDrift"
+
+	// TypeUserDataDrift indicates whether the user data differs from the
+	// one the Server was created with, while the Server may be recreated.
+	TypeUserDataDrift xpv1.ConditionType = "UserDataDrift"
+)
+
+// Condition types of a Certificate.
+const (
+	// TypeCertificateDrift indicates whether the Certificate differs from
+	// the spec in a way that requires recreating it.
+	TypeCertificateDrift xpv1.ConditionType = "CertificateDrift"
+)
+
+// Condition types of a Firewall.
+const (
+	// TypeRuleSetMissing indicates whether a FirewallRuleSet the Firewall
+	// references does not exist.
+	TypeRuleSetMissing xpv1.ConditionType = "RuleSetMissing"
+)
+
+// Condition types of all resources.
+const (
+	// TypeDeletionProtected indicates that deleting the resource was refused
+	// because of its delete protection.
+	TypeDeletionProtected xpv1.ConditionType = "DeletionProtected"
+)
+
+// Reasons of the conditions.
+const (
+	ReasonDrifted   xpv1.ConditionReason = "Drifted"
+	ReasonUpToDate  xpv1.ConditionReason = "UpToDate"
+	ReasonProtected xpv1.ConditionReason = "Protected"
+
+	ReasonUnavailable xpv1.ConditionReason = "Unavailable"
+	ReasonUntracked   xpv1.ConditionReason = "Untracked"
+
+	ReasonMissing  xpv1.ConditionReason = "Missing"
+	ReasonResolved xpv1.ConditionReason = "Resolved"
+)
+
+// ServerTypeDrifted returns a condition reporting that the Server runs as a
+// different Server type than the desired one.
+func ServerTypeDrifted(actual, desired string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeServerTypeDrift,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDrifted,
+		Message:            fmt.Sprintf("Server type is %s instead of %s, rescaling requires downtime", actual, desired),
+	}
+}
+
+// ServerTypeUpToDate returns a condition reporting that the Server runs as
+// the desired Server type.
+func ServerTypeUpToDate() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeServerTypeDrift,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpToDate,
+	}
+}
+
+// UserDataDrifted returns a condition reporting that the user data differs
+// from the one the Server was created with.
+func UserDataDrifted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUserDataDrift,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDrifted,
+		Message:            "User data differs from the one the Server was created with, the Server is recreated",
+	}
+}
+
+// UserDataUnavailable returns a condition reporting that the user data could
+// not be read, so that it is unknown whether it differs.
+func UserDataUnavailable(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUserDataDrift,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnavailable,
+		Message:            err.Error(),
+	}
+}
+
+// UserDataUntracked returns a condition reporting that the user data is no
+// longer tracked because recreateOnUserDataChange is not set.
+func UserDataUntracked() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUserDataDrift,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUntracked,
+		Message:            "User data is only tracked while recreateOnUserDataChange is set",
+	}
+}
+
+// UserDataUpToDate returns a condition reporting that the Server was created
+// with the desired user data.
+func UserDataUpToDate() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUserDataDrift,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpToDate,
+	}
+}
+
+// CertificateDrifted returns a condition reporting that the Certificate
+// differs from the spec in the given field.
+func CertificateDrifted(field string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCertificateDrift,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDrifted,
+		Message:            fmt.Sprintf("Certificate differs from the spec in its %s, Certificates cannot be changed and have to be recreated", field),
+	}
+}
+
+// CertificateUpToDate returns a condition reporting that the Certificate
+// matches the spec.
+func CertificateUpToDate() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCertificateDrift,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpToDate,
+	}
+}
+
+// RuleSetsMissing returns a condition reporting that the FirewallRuleSets of
+// the given names do not exist, so that the rules of the Firewall are left
+// unchanged.
+func RuleSetsMissing(names []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRuleSetMissing,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonMissing,
+		Message:            fmt.Sprintf("FirewallRuleSets %s do not exist, the rules of the Firewall are left unchanged", strings.Join(names, ", ")),
+	}
+}
+
+// RuleSetsResolved returns a condition reporting that all FirewallRuleSets
+// the Firewall references exist.
+func RuleSetsResolved() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRuleSetMissing,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonResolved,
+	}
+}
+
+// DeletionProtected returns a condition reporting that the resource was not
+// deleted because delete protection is enabled.
+func DeletionProtected() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionProtected,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonProtected,
+		Message:            "Delete protection is enabled, set spec.forProvider.protection.delete to false to delete the resource",
+	}
+}
@@ -25,6 +25,11 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// FirewallResource is a resource a Firewall is applied to.
+// +kubebuilder:validation:XValidation:rule="self.type != 'server' || has(self.server) || has(self.server_ref) || has(self.server_selector)",message="server, server_ref or server_selector is required for type server"
+// +kubebuilder:validation:XValidation:rule="self.type != 'label_selector' || has(self.label_selector)",message="label_selector is required for type label_selector"
+// +kubebuilder:validation:XValidation:rule="self.type != 'label_selector' || !(has(self.server) || has(self.server_ref) || has(self.server_selector))",message="server, server_ref and server_selector are not allowed for type label_selector"
+// +kubebuilder:validation:XValidation:rule="self.type != 'server' || !has(self.label_selector)",message="label_selector is not allowed for type server"
 type FirewallResource struct {
 	// +kubebuilder:validation:Enum=server;label_selector
 	Type string `json:"type"`
@@ -32,19 +37,50 @@ type FirewallResource struct {
 	// +optional
 	LabelSelector *string `json:"label_selector,omitempty"`
 
+	// Server is the ID of the Server the Firewall is applied to.
+	// +crossplane:generate:reference:type=Server
+	// +crossplane:generate:reference:extractor=ID()
 	// +optional
-	Server *int `json:"server,omitempty"`
+	Server *string `json:"server,omitempty"`
+
+	// ServerRef references the Server the Firewall is applied to.
+	// +optional
+	ServerRef *xpv1.Reference `json:"server_ref,omitempty"`
+
+	// ServerSelector selects a reference to the Server the Firewall is
+	// applied to.
+	// +optional
+	ServerSelector *xpv1.Selector `json:"server_selector,omitempty"`
 }
 
+// CIDRs are IPv4 or IPv6 networks in CIDR notation.
+// +kubebuilder:validation:MaxItems=100
+type CIDRs []CIDR
+
+// CIDR is an IPv4 or IPv6 network in CIDR notation. Host bits must not be set,
+// e.g. 10.0.0.0/8 rather than 10.1.2.3/8, as they would silently be ignored.
+// +kubebuilder:validation:XValidation:rule="self.contains(':') ? self.matches('^(([0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}|(([0-9a-fA-F]{1,4}:)*[0-9a-fA-F]{1,4})?::(([0-9a-fA-F]{1,4}:)*[0-9a-fA-F]{1,4})?)/([0-9]|[1-9][0-9]|1[01][0-9]|12[0-8])$') && (!self.contains('::') || [self.split('/')[0]].all(a, size(a.split(':')) - (a.startsWith('::') || a.endsWith('::') ? 2 : 1) <= 7)) : self.matches('^((25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])[.]){3}(25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])/([0-9]|[12][0-9]|3[0-2])$')",message="must be an IPv4 or IPv6 CIDR"
+// +kubebuilder:validation:XValidation:rule="self.contains(':') ? !self.matches('^[0-9a-fA-F:]+/[0-9]{1,3}$') || [self.split('/')[0]].all(a, [int(self.split('/')[1])].all(p, [size(a.split(':'))].all(s, [a.split('::')[0]].all(l, [size(l) == 0 ? 0 : size(l.split(':'))].all(n, [0, 1, 2, 3, 4, 5, 6, 7, 8].all(j, j >= s || 16 * (j < n ? j : j + 8 - s) < p || '0000'.startsWith(a.split(':')[j])) && [p / 16].all(h, h >= 8 || [h < n ? h : h - 8 + s].all(i, i < n && h >= n || a.split(':')[i].matches(['^0{0,4}$', '^(0{0,4}|[0-9a-fA-F]{0,0}[08]0{3})$', '^(0{0,4}|[0-9a-fA-F]{0,0}[048cC]0{3})$', '^(0{0,4}|[0-9a-fA-F]{0,0}[02468aceACE]0{3})$', '^(0{0,4}|[0-9a-fA-F]{0,1}0{3})$', '^(0{0,4}|[0-9a-fA-F]{0,1}[08]0{2})$', '^(0{0,4}|[0-9a-fA-F]{0,1}[048cC]0{2})$', '^(0{0,4}|[0-9a-fA-F]{0,1}[02468aceACE]0{2})$', '^(0{0,4}|[0-9a-fA-F]{0,2}0{2})$', '^(0{0,4}|[0-9a-fA-F]{0,2}[08]0{1})$', '^(0{0,4}|[0-9a-fA-F]{0,2}[048cC]0{1})$', '^(0{0,4}|[0-9a-fA-F]{0,2}[02468aceACE]0{1})$', '^(0{0,4}|[0-9a-fA-F]{0,3}0{1})$', '^(0{0,4}|[0-9a-fA-F]{0,3}[08])$', '^(0{0,4}|[0-9a-fA-F]{0,3}[048cC])$', '^(0{0,4}|[0-9a-fA-F]{0,3}[02468aceACE])$'][p % 16])))))))) : !self.matches('^([0-9]{1,3}[.]){3}[0-9]{1,3}/[0-9]{1,2}$') || [int(self.split('/')[1])].all(p, [self.split('/')[0].split('.')].all(o, [0, 1, 2, 3].all(i, p >= 8 * i + 8 || int(o[i]) % [256, 128, 64, 32, 16, 8, 4, 2, 1][p <= 8 * i ? 0 : p - 8 * i] == 0)))",message="must not have host bits set, e.g. 10.0.0.0/8 rather than 10.1.2.3/8"
+// +kubebuilder:validation:MaxLength=43
+type CIDR string
+
+// FirewallRule is a rule of a Firewall. Inbound rules match their
+// SourceIPs and outbound rules their DestinationIPs.
+// +kubebuilder:validation:XValidation:rule="self.direction != 'in' || (has(self.source_ips) && size(self.source_ips) > 0)",message="source_ips are required for direction in"
+// +kubebuilder:validation:XValidation:rule="self.direction != 'out' || (has(self.destination_ips) && size(self.destination_ips) > 0)",message="destination_ips are required for direction out"
+// +kubebuilder:validation:XValidation:rule="self.protocol in ['tcp', 'udp'] || !has(self.port)",message="port is only allowed for protocols tcp and udp"
+// +kubebuilder:validation:XValidation:rule="!has(self.port) || !self.port.contains('-') || int(self.port.split('-')[0]) <= int(self.port.split('-')[1])",message="port ranges must not be reversed"
 type FirewallRule struct {
 	// +optional
 	Description *string `json:"description,omitempty"`
 
+	// SourceIPs are the CIDRs inbound traffic is allowed from.
 	// +optional
-	SourceIPs []string `json:"source_ips,omitempty"`
+	SourceIPs CIDRs `json:"source_ips,omitempty"`
 
+	// DestinationIPs are the CIDRs outbound traffic is allowed to.
 	// +optional
-	DestinationIPs []string `json:"destination_ips,omitempty"`
+	DestinationIPs CIDRs `json:"destination_ips,omitempty"`
 
 	// +kubebuilder:validation:Enum=in;out
 	Direction string `json:"direction"`
@@ -52,15 +88,35 @@ type FirewallRule struct {
 	// +kubebuilder:validation:Enum=tcp;udp;icmp;esp;gre
 	Protocol string `json:"protocol"`
 
+	// Port is a port from 1 to 65535, a port range like 1024-5000, or any.
+	// +kubebuilder:validation:MaxLength=11
+	// +kubebuilder:validation:Pattern=`^(([1-9][0-9]{0,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5])(-([1-9][0-9]{0,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]))?|any)$`
 	// +optional
-	Port *string `json:"port"`
+	Port *string `json:"port,omitempty"`
+}
+
+// A FirewallRuleSetReference references a FirewallRuleSet by name.
+type FirewallRuleSetReference struct {
+	// Name of the referenced FirewallRuleSet.
+	Name string `json:"name"`
 }
 
 // FirewallParameters are the configurable fields of a Firewall.
 type FirewallParameters struct {
+	// +kubebuilder:validation:MaxItems=50
 	// +optional
 	Rules []FirewallRule `json:"rules,omitempty"`
 
+	// RuleSetRefs reference FirewallRuleSets whose rules are added to Rules.
+	// Identical rules are only added once. While a referenced FirewallRuleSet
+	// does not exist, the rules are left unchanged and the RuleSetMissing
+	// condition reports it.
+	// +optional
+	RuleSetRefs []FirewallRuleSetReference `json:"ruleSetRefs,omitempty"`
+
+	// ApplyTo are the resources the Firewall is applied to. Resources removed
+	// from it stay applied while a FirewallAttachment attaches the Firewall
+	// to them.
 	// +optional
 	ApplyTo []FirewallResource `json:"apply_to,omitempty"`
 
@@ -72,6 +128,32 @@ type FirewallParameters struct {
 type FirewallObservation struct {
 	Id      int          `json:"id"`
 	Created *metav1.Time `json:"created,omitempty"`
+
+	// AppliedTo are the resources the Firewall is applied to.
+	// +optional
+	AppliedTo []FirewallAppliedTo `json:"applied_to,omitempty"`
+
+	// ManagedAppliedTo are the resources the Firewall was applied to from
+	// its spec. They are removed once they are not in the spec anymore,
+	// unlike resources the Firewall was applied to otherwise.
+	// +optional
+	ManagedAppliedTo []FirewallAppliedTo `json:"managed_applied_to,omitempty"`
+
+	// Servers are the IDs of the Servers the Firewall covers, directly or
+	// through label selectors.
+	// +optional
+	Servers []int `json:"servers,omitempty"`
+}
+
+// FirewallAppliedTo is a resource a Firewall is applied to.
+type FirewallAppliedTo struct {
+	Type string `json:"type"`
+
+	// +optional
+	Server *int `json:"server,omitempty"`
+
+	// +optional
+	LabelSelector string `json:"label_selector,omitempty"`
 }
 
 // A FirewallSpec defines the desired state of a Firewall.
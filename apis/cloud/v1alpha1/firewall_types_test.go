@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"math"
+	"os"
+	"testing"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/validation"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+	"sigs.k8s.io/yaml"
+)
+
+// ruleValidator validates FirewallRules the way the apiserver does, using the
+// schema of the generated FirewallRuleSet CRD.
+type ruleValidator struct {
+	structural *structuralschema.Structural
+	schema     *validate.SchemaValidator
+	cel        *cel.Validator
+}
+
+// loadRuleSetCRD returns the generated FirewallRuleSet CRD.
+func loadRuleSetCRD(t *testing.T) *apiextensions.CustomResourceDefinition {
+	t.Helper()
+
+	b, err := os.ReadFile("../../../package/crds/cloud.hetzner.crossplane.io_firewallrulesets.yaml")
+	if err != nil {
+		t.Fatalf("cannot read CRD: %v", err)
+	}
+	v1 := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal(b, v1); err != nil {
+		t.Fatalf("cannot parse CRD: %v", err)
+	}
+	s := runtime.NewScheme()
+	if err := apiextensionsv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := apiextensions.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	crd := &apiextensions.CustomResourceDefinition{}
+	if err := s.Convert(v1, crd, nil); err != nil {
+		t.Fatalf("cannot convert CRD: %v", err)
+	}
+	return crd
+}
+
+func newRuleValidator(t *testing.T) *ruleValidator {
+	t.Helper()
+
+	crd := loadRuleSetCRD(t)
+	// The schema of the only version is hoisted to the top level.
+	val := crd.Spec.Validation
+	structural, err := structuralschema.NewStructural(val.OpenAPIV3Schema)
+	if err != nil {
+		t.Fatalf("cannot build structural schema: %v", err)
+	}
+	sv, _, err := apiservervalidation.NewSchemaValidator(val)
+	if err != nil {
+		t.Fatalf("cannot build schema validator: %v", err)
+	}
+	return &ruleValidator{structural: structural, schema: sv, cel: cel.NewValidator(structural, true, math.MaxInt64)}
+}
+
+// validate returns the errors the apiserver reports for a FirewallRuleSet
+// with the given rule.
+func (v *ruleValidator) validate(rule map[string]interface{}) field.ErrorList {
+	obj := map[string]interface{}{
+		"apiVersion": SchemeGroupVersion.String(),
+		"kind":       FirewallRuleSetKind,
+		"metadata":   map[string]interface{}{"name": "test"},
+		"spec":       map[string]interface{}{"rules": []interface{}{rule}},
+	}
+	errs := apiservervalidation.ValidateCustomResource(field.NewPath(""), obj, v.schema)
+	celErrs, _ := v.cel.Validate(context.Background(), nil, v.structural, obj, nil, math.MaxInt64)
+	return append(errs, celErrs...)
+}
+
+func TestRuleSetCRD(t *testing.T) {
+	crd := loadRuleSetCRD(t)
+	crd.Status.StoredVersions = []string{SchemeGroupVersion.Version}
+
+	// This includes the estimated cost of the CEL rules, which the apiserver
+	// rejects CRDs for once it exceeds its budget.
+	if errs := validation.ValidateCustomResourceDefinition(context.Background(), crd); len(errs) > 0 {
+		t.Errorf("ValidateCustomResourceDefinition(...): unexpected errors: %v", errs)
+	}
+}
+
+func TestCIDRValidation(t *testing.T) {
+	v := newRuleValidator(t)
+
+	cases := map[string]struct {
+		reason string
+		cidr   string
+		valid  bool
+	}{
+		"IPv4Any":            {reason: "The IPv4 default route is a network.", cidr: "0.0.0.0/0", valid: true},
+		"IPv4Network":        {reason: "An IPv4 network without host bits is valid.", cidr: "10.0.0.0/8", valid: true},
+		"IPv4Partial":        {reason: "Prefixes that end within an octet are checked bitwise.", cidr: "172.16.0.0/12", valid: true},
+		"IPv4Host":           {reason: "A single IPv4 address is a /32 network.", cidr: "1.2.3.4/32", valid: true},
+		"IPv4HostBits":       {reason: "IPv4 host bits must not be set.", cidr: "10.1.2.3/8"},
+		"IPv4PartialBits":    {reason: "IPv4 host bits within the octet the prefix ends in must not be set.", cidr: "172.17.0.0/12"},
+		"IPv4LongPrefix":     {reason: "IPv4 prefixes are at most 32 bits long.", cidr: "1.2.3.4/33"},
+		"IPv4BadOctet":       {reason: "IPv4 octets are at most 255.", cidr: "256.0.0.0/8"},
+		"IPv4NoPrefix":       {reason: "A CIDR needs a prefix length.", cidr: "10.0.0.0"},
+		"IPv6Any":            {reason: "The IPv6 default route is a network.", cidr: "::/0", valid: true},
+		"IPv6Network":        {reason: "An IPv6 network without host bits is valid.", cidr: "2001:db8::/32", valid: true},
+		"IPv6UpperCase":      {reason: "IPv6 hextets may be upper case.", cidr: "2001:DB8::/32", valid: true},
+		"IPv6Expanded":       {reason: "IPv6 addresses may be written out in full.", cidr: "2001:0db8:0000:0000:0000:0000:0000:0000/32", valid: true},
+		"IPv6Partial":        {reason: "Prefixes that end within a hextet are checked bitwise.", cidr: "fe80::/10", valid: true},
+		"IPv6Host":           {reason: "A single IPv6 address is a /128 network.", cidr: "::1/128", valid: true},
+		"IPv6HostBits":       {reason: "IPv6 host bits must not be set.", cidr: "2001:db8::1/32"},
+		"IPv6PartialBits":    {reason: "IPv6 host bits within the hextet the prefix ends in must not be set.", cidr: "fe80:1::/10"},
+		"IPv6LoopbackPrefix": {reason: "The loopback address has host bits below /128.", cidr: "::1/127"},
+		"IPv6LongPrefix":     {reason: "IPv6 prefixes are at most 128 bits long.", cidr: "2001:db8::/129"},
+		"IPv6DoubleGap":      {reason: ":: may only appear once.", cidr: "2001::db8::/32"},
+		"IPv6GapShifted":     {reason: "Hextets after :: are counted from the end of the address.", cidr: "2001:db8::1:0:0/96", valid: true},
+		"IPv6GapHostBits":    {reason: "Hextets after :: that lie behind the prefix must be zero.", cidr: "2001:db8::1:0:0/80"},
+		"IPv6GapLeading":     {reason: ":: may lead the address.", cidr: "::ffff:0:0/96", valid: true},
+		"IPv6GapTrailing":    {reason: ":: may end the address.", cidr: "2001:db8:1::/48", valid: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			errs := v.validate(map[string]interface{}{
+				"direction":  "in",
+				"protocol":   "icmp",
+				"source_ips": []interface{}{tc.cidr},
+			})
+			if valid := len(errs) == 0; valid != tc.valid {
+				t.Errorf("\n%s\nvalidate(%q): want valid %t, got errors: %v\n", tc.reason, tc.cidr, tc.valid, errs)
+			}
+		})
+	}
+}
+
+func TestFirewallRuleValidation(t *testing.T) {
+	v := newRuleValidator(t)
+
+	rule := func(protocol, port string) map[string]interface{} {
+		r := map[string]interface{}{
+			"direction":  "in",
+			"protocol":   protocol,
+			"source_ips": []interface{}{"0.0.0.0/0"},
+		}
+		if port != "" {
+			r["port"] = port
+		}
+		return r
+	}
+
+	cases := map[string]struct {
+		reason string
+		rule   map[string]interface{}
+		valid  bool
+	}{
+		"TCPPort":         {reason: "TCP rules may have a port.", rule: rule("tcp", "443"), valid: true},
+		"UDPRange":        {reason: "UDP rules may have a port range.", rule: rule("udp", "1024-5000"), valid: true},
+		"SinglePortRange": {reason: "A port range may start and end at the same port.", rule: rule("tcp", "80-80"), valid: true},
+		"AnyPort":         {reason: "TCP rules may allow any port.", rule: rule("tcp", "any"), valid: true},
+		"ICMP":            {reason: "ICMP rules need no port.", rule: rule("icmp", ""), valid: true},
+		"ICMPPort":        {reason: "Only TCP and UDP rules may have a port.", rule: rule("icmp", "80")},
+		"ESPPort":         {reason: "Only TCP and UDP rules may have a port.", rule: rule("esp", "80")},
+		"ReversedRange":   {reason: "Port ranges must not be reversed.", rule: rule("tcp", "5000-1024")},
+		"PortTooHigh":     {reason: "Ports are at most 65535.", rule: rule("tcp", "65536")},
+		"PortZero":        {reason: "Ports start at 1.", rule: rule("udp", "0")},
+		"NoSourceIPs": {
+			reason: "Inbound rules need source IPs.",
+			rule:   map[string]interface{}{"direction": "in", "protocol": "icmp"},
+		},
+		"NoDestinationIPs": {
+			reason: "Outbound rules need destination IPs.",
+			rule:   map[string]interface{}{"direction": "out", "protocol": "icmp", "source_ips": []interface{}{"0.0.0.0/0"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			errs := v.validate(tc.rule)
+			if valid := len(errs) == 0; valid != tc.valid {
+				t.Errorf("\n%s\nvalidate(%v): want valid %t, got errors: %v\n", tc.reason, tc.rule, tc.valid, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FirewallAttachmentParameters are the configurable fields of a
+// FirewallAttachment. Exactly one of Server and LabelSelector is attached.
+// +kubebuilder:validation:XValidation:rule="has(self.labelSelector) != (has(self.server) || has(self.serverRef) || has(self.serverSelector))",message="exactly one of a server and labelSelector is required"
+type FirewallAttachmentParameters struct {
+	// Firewall is the ID of the Firewall to attach.
+	// +crossplane:generate:reference:type=Firewall
+	// +crossplane:generate:reference:extractor=ID()
+	// +optional
+	Firewall *string `json:"firewall,omitempty"`
+
+	// FirewallRef references the Firewall to attach.
+	// +optional
+	FirewallRef *xpv1.Reference `json:"firewallRef,omitempty"`
+
+	// FirewallSelector selects a reference to the Firewall to attach.
+	// +optional
+	FirewallSelector *xpv1.Selector `json:"firewallSelector,omitempty"`
+
+	// Server is the ID of the Server the Firewall is attached to.
+	// +crossplane:generate:reference:type=Server
+	// +crossplane:generate:reference:extractor=ID()
+	// +optional
+	Server *string `json:"server,omitempty"`
+
+	// ServerRef references the Server the Firewall is attached to.
+	// +optional
+	ServerRef *xpv1.Reference `json:"serverRef,omitempty"`
+
+	// ServerSelector selects a reference to the Server the Firewall is
+	// attached to.
+	// +optional
+	ServerSelector *xpv1.Selector `json:"serverSelector,omitempty"`
+
+	// LabelSelector attaches the Firewall to all Servers it matches.
+	// +optional
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
+
+// FirewallAttachmentObservation are the observable fields of a
+// FirewallAttachment.
+type FirewallAttachmentObservation struct {
+	Firewall int `json:"firewall"`
+
+	// Attached is the resource the attachment applied the Firewall to. It is
+	// removed from the Firewall when the attachment is changed or deleted. A
+	// Firewall that was already applied to the resource is not recorded and
+	// left as it is.
+	// +optional
+	Attached *FirewallAppliedTo `json:"attached,omitempty"`
+}
+
+// A FirewallAttachmentSpec defines the desired state of a FirewallAttachment.
+type FirewallAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FirewallAttachmentParameters `json:"forProvider"`
+}
+
+// A FirewallAttachmentStatus represents the observed state of a FirewallAttachment.
+type FirewallAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FirewallAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FirewallAttachment applies a Firewall to a Server or to the Servers a
+// label selector matches. Keeping it apart from the Firewall lets the owners
+// of Servers attach them to a shared Firewall without changing its spec.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FIREWALL",type="integer",JSONPath=".status.atProvider.firewall"
+// +kubebuilder:printcolumn:name="SERVER",type="integer",JSONPath=".status.atProvider.attached.server"
+// +kubebuilder:printcolumn:name="LABEL-SELECTOR",type="string",JSONPath=".status.atProvider.attached.label_selector"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
+type FirewallAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FirewallAttachmentSpec   `json:"spec"`
+	Status FirewallAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FirewallAttachmentList contains a list of FirewallAttachment
+type FirewallAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FirewallAttachment `json:"items"`
+}
+
+// FirewallAttachment type metadata.
+var (
+	FirewallAttachmentKind             = reflect.TypeOf(FirewallAttachment{}).Name()
+	FirewallAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: FirewallAttachmentKind}.String()
+	FirewallAttachmentKindAPIVersion   = FirewallAttachmentKind + "." + SchemeGroupVersion.String()
+	FirewallAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(FirewallAttachmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FirewallAttachment{}, &FirewallAttachmentList{})
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A FirewallRuleSetSpec defines the rules of a FirewallRuleSet.
+type FirewallRuleSetSpec struct {
+	// Rules are added to the rules of every Firewall referencing the
+	// FirewallRuleSet.
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	Rules []FirewallRule `json:"rules,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FirewallRuleSet is a reusable set of rules Firewalls can reference. It is
+// not a managed resource and has no external counterpart.
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,hetzner}
+type FirewallRuleSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec FirewallRuleSetSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// FirewallRuleSetList contains a list of FirewallRuleSet
+type FirewallRuleSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FirewallRuleSet `json:"items"`
+}
+
+// FirewallRuleSet type metadata.
+var (
+	FirewallRuleSetKind             = reflect.TypeOf(FirewallRuleSet{}).Name()
+	FirewallRuleSetGroupKind        = schema.GroupKind{Group: Group, Kind: FirewallRuleSetKind}.String()
+	FirewallRuleSetKindAPIVersion   = FirewallRuleSetKind + "." + SchemeGroupVersion.String()
+	FirewallRuleSetGroupVersionKind = SchemeGroupVersion.WithKind(FirewallRuleSetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FirewallRuleSet{}, &FirewallRuleSetList{})
+}
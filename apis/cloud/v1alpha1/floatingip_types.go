@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FloatingIPParameters are the configurable fields of a FloatingIP.
+// +kubebuilder:validation:XValidation:rule="!(has(self.dnsPtr) && has(self.rdns))",message="only one of dnsPtr and rdns can be set"
+type FloatingIPParameters struct {
+	// +kubebuilder:validation:Enum=ipv4;ipv6
+	Type string `json:"type"`
+
+	// HomeLocation is the ID or name of the Location the FloatingIP is
+	// routed to best.
+	HomeLocation intstr.IntOrString `json:"homeLocation"`
+
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DNSPtr is the reverse DNS entry of the FloatingIP. For IPv6 it is set
+	// for the ::1 address of the assigned network. It cannot be set together
+	// with RDNS.
+	// +optional
+	DNSPtr *string `json:"dnsPtr,omitempty"`
+
+	// RDNS are the reverse DNS entries of the FloatingIP. Addresses no longer
+	// listed keep their last entry. It cannot be set together with DNSPtr.
+	// +optional
+	RDNS []ReverseDNS `json:"rdns,omitempty"`
+
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	Protection *Protection `json:"protection,omitempty"`
+}
+
+// FloatingIPObservation are the observable fields of a FloatingIP.
+type FloatingIPObservation struct {
+	Id      int          `json:"id"`
+	Created *metav1.Time `json:"created,omitempty"`
+	IP      string       `json:"ip"`
+	Server  *int         `json:"server,omitempty"`
+}
+
+// A FloatingIPSpec defines the desired state of a FloatingIP.
+type FloatingIPSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FloatingIPParameters `json:"forProvider"`
+}
+
+// A FloatingIPStatus represents the observed state of a FloatingIP.
+type FloatingIPStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FloatingIPObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FloatingIP is an IP address that can be moved between Servers.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name",priority=1
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ip"
+// +kubebuilder:printcolumn:name="SERVER",type="integer",JSONPath=".status.atProvider.server",priority=10
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
+type FloatingIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FloatingIPSpec   `json:"spec"`
+	Status FloatingIPStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FloatingIPList contains a list of FloatingIP
+type FloatingIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FloatingIP `json:"items"`
+}
+
+// FloatingIP type metadata.
+var (
+	FloatingIPKind             = reflect.TypeOf(FloatingIP{}).Name()
+	FloatingIPGroupKind        = schema.GroupKind{Group: Group, Kind: FloatingIPKind}.String()
+	FloatingIPKindAPIVersion   = FloatingIPKind + "." + SchemeGroupVersion.String()
+	FloatingIPGroupVersionKind = SchemeGroupVersion.WithKind(FloatingIPKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FloatingIP{}, &FloatingIPList{})
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FloatingIPAssignmentParameters are the configurable fields of a
+// FloatingIPAssignment.
+type FloatingIPAssignmentParameters struct {
+	// FloatingIP is the ID of the FloatingIP to assign.
+	// +crossplane:generate:reference:type=FloatingIP
+	// +crossplane:generate:reference:extractor=ID()
+	// +optional
+	FloatingIP *string `json:"floatingIP,omitempty"`
+
+	// FloatingIPRef references the FloatingIP to assign.
+	// +optional
+	FloatingIPRef *xpv1.Reference `json:"floatingIPRef,omitempty"`
+
+	// FloatingIPSelector selects a reference to the FloatingIP to assign.
+	// +optional
+	FloatingIPSelector *xpv1.Selector `json:"floatingIPSelector,omitempty"`
+
+	// Server is the ID of the Server the FloatingIP is assigned to.
+	// +crossplane:generate:reference:type=Server
+	// +crossplane:generate:reference:extractor=ID()
+	// +optional
+	Server *string `json:"server,omitempty"`
+
+	// ServerRef references the Server the FloatingIP is assigned to. Set its
+	// resolve policy to Always to reassign the FloatingIP when the reference
+	// is changed.
+	// +optional
+	ServerRef *xpv1.Reference `json:"serverRef,omitempty"`
+
+	// ServerSelector selects a reference to the Server the FloatingIP is
+	// assigned to.
+	// +optional
+	ServerSelector *xpv1.Selector `json:"serverSelector,omitempty"`
+}
+
+// FloatingIPAssignmentObservation are the observable fields of a
+// FloatingIPAssignment.
+type FloatingIPAssignmentObservation struct {
+	IP     string `json:"ip"`
+	Server int    `json:"server"`
+}
+
+// A FloatingIPAssignmentSpec defines the desired state of a FloatingIPAssignment.
+type FloatingIPAssignmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FloatingIPAssignmentParameters `json:"forProvider"`
+}
+
+// A FloatingIPAssignmentStatus represents the observed state of a FloatingIPAssignment.
+type FloatingIPAssignmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FloatingIPAssignmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FloatingIPAssignment routes a FloatingIP to a Server. Keeping it apart from
+// the FloatingIP lets the address outlive the Servers it is assigned to.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ip"
+// +kubebuilder:printcolumn:name="SERVER",type="integer",JSONPath=".status.atProvider.server"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
+type FloatingIPAssignment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FloatingIPAssignmentSpec   `json:"spec"`
+	Status FloatingIPAssignmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FloatingIPAssignmentList contains a list of FloatingIPAssignment
+type FloatingIPAssignmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FloatingIPAssignment `json:"items"`
+}
+
+// FloatingIPAssignment type metadata.
+var (
+	FloatingIPAssignmentKind             = reflect.TypeOf(FloatingIPAssignment{}).Name()
+	FloatingIPAssignmentGroupKind        = schema.GroupKind{Group: Group, Kind: FloatingIPAssignmentKind}.String()
+	FloatingIPAssignmentKindAPIVersion   = FloatingIPAssignmentKind + "." + SchemeGroupVersion.String()
+	FloatingIPAssignmentGroupVersionKind = SchemeGroupVersion.WithKind(FloatingIPAssignmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FloatingIPAssignment{}, &FloatingIPAssignmentList{})
+}
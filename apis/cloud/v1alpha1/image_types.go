@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ImageParameters are the configurable fields of an Image.
+type ImageParameters struct {
+	// Server is the ID of the Server the Image is captured from.
+	// +crossplane:generate:reference:type=Server
+	// +crossplane:generate:reference:extractor=ID()
+	// +optional
+	Server *string `json:"server,omitempty"`
+
+	// ServerRef references the Server the Image is captured from.
+	// +optional
+	ServerRef *xpv1.Reference `json:"serverRef,omitempty"`
+
+	// ServerSelector selects a reference to the Server the Image is
+	// captured from.
+	// +optional
+	ServerSelector *xpv1.Selector `json:"serverSelector,omitempty"`
+
+	// +kubebuilder:validation:Enum=snapshot;backup
+	// +kubebuilder:default=snapshot
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	Protection *Protection `json:"protection,omitempty"`
+}
+
+// ImageObservation are the observable fields of an Image.
+type ImageObservation struct {
+	Id          int          `json:"id"`
+	Created     *metav1.Time `json:"created,omitempty"`
+	Type        string       `json:"type"`
+	Status      string       `json:"status"`
+	ImageSize   string       `json:"imageSize,omitempty"`
+	DiskSize    string       `json:"diskSize,omitempty"`
+	OSFlavor    string       `json:"osFlavor,omitempty"`
+	CreatedFrom *int         `json:"createdFrom,omitempty"`
+}
+
+// An ImageSpec defines the desired state of an Image.
+type ImageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImageParameters `json:"forProvider"`
+}
+
+// An ImageStatus represents the observed state of an Image.
+type ImageStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ImageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Image is a snapshot or backup captured from the disk of a Server. Its
+// external name is the ID of the Image.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name",priority=1
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="SIZE",type="string",JSONPath=".status.atProvider.imageSize",priority=10
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
+type Image struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageSpec   `json:"spec"`
+	Status ImageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageList contains a list of Image
+type ImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Image `json:"items"`
+}
+
+// Image type metadata.
+var (
+	ImageKind             = reflect.TypeOf(Image{}).Name()
+	ImageGroupKind        = schema.GroupKind{Group: Group, Kind: ImageKind}.String()
+	ImageKindAPIVersion   = ImageKind + "." + SchemeGroupVersion.String()
+	ImageGroupVersionKind = SchemeGroupVersion.WithKind(ImageKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Image{}, &ImageList{})
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LoadBalancerHealthCheckHTTP configures the HTTP requests of a health check.
+type LoadBalancerHealthCheckHTTP struct {
+	// +optional
+	Domain *string `json:"domain,omitempty"`
+
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Response is the string expected in the response body.
+	// +optional
+	Response *string `json:"response,omitempty"`
+
+	// StatusCodes are the accepted response status codes, e.g. 2??.
+	// +optional
+	StatusCodes []string `json:"status_codes,omitempty"`
+
+	// +optional
+	TLS *bool `json:"tls,omitempty"`
+}
+
+// LoadBalancerHealthCheck configures how the targets of a service are checked.
+type LoadBalancerHealthCheck struct {
+	// +kubebuilder:validation:Enum=tcp;http
+	Protocol string `json:"protocol"`
+
+	Port int `json:"port"`
+
+	// Interval between health checks in seconds.
+	Interval int `json:"interval"`
+
+	// Timeout of a single health check in seconds.
+	Timeout int `json:"timeout"`
+
+	// Retries before a target is considered unhealthy.
+	Retries int `json:"retries"`
+
+	// +optional
+	HTTP *LoadBalancerHealthCheckHTTP `json:"http,omitempty"`
+}
+
+// LoadBalancerServiceHTTP configures http and https services.
+type LoadBalancerServiceHTTP struct {
+	// +optional
+	CookieName *string `json:"cookie_name,omitempty"`
+
+	// CookieLifetime of the sticky session cookie in seconds.
+	// +optional
+	CookieLifetime *int `json:"cookie_lifetime,omitempty"`
+
+	// Certificates are the IDs of the Certificates used by https services.
+	// +optional
+	Certificates []int `json:"certificates,omitempty"`
+
+	// RedirectHTTP redirects traffic from port 80 to port 443.
+	// +optional
+	RedirectHTTP *bool `json:"redirect_http,omitempty"`
+
+	// +optional
+	StickySessions *bool `json:"sticky_sessions,omitempty"`
+}
+
+// LoadBalancerService is a port the LoadBalancer listens on and forwards to
+// its targets.
+type LoadBalancerService struct {
+	// +kubebuilder:validation:Enum=tcp;http;https
+	Protocol string `json:"protocol"`
+
+	// ListenPort identifies the service within the LoadBalancer.
+	ListenPort int `json:"listen_port"`
+
+	DestinationPort int `json:"destination_port"`
+
+	// +optional
+	Proxyprotocol *bool `json:"proxyprotocol,omitempty"`
+
+	// +optional
+	HTTP *LoadBalancerServiceHTTP `json:"http,omitempty"`
+
+	// HealthCheck defaults to a check of the destination port when omitted.
+	// +optional
+	HealthCheck *LoadBalancerHealthCheck `json:"health_check,omitempty"`
+}
+
+// LoadBalancerTarget is a destination of the traffic of a LoadBalancer.
+type LoadBalancerTarget struct {
+	// +kubebuilder:validation:Enum=server;label_selector;ip
+	Type string `json:"type"`
+
+	// Server is the ID of the Server to target.
+	// +optional
+	Server *int `json:"server,omitempty"`
+
+	// +optional
+	LabelSelector *string `json:"label_selector,omitempty"`
+
+	// +optional
+	IP *string `json:"ip,omitempty"`
+
+	// UsePrivateIP sends the traffic through the private Network instead of
+	// the public interface of a target.
+	// +optional
+	UsePrivateIP *bool `json:"use_private_ip,omitempty"`
+}
+
+// LoadBalancerParameters are the configurable fields of a LoadBalancer.
+type LoadBalancerParameters struct {
+	// Type is the ID or name of the Load Balancer type, e.g. lb11.
+	Type intstr.IntOrString `json:"type"`
+
+	// +kubebuilder:validation:Enum=round_robin;least_connections
+	// +optional
+	Algorithm *string `json:"algorithm,omitempty"`
+
+	// Location is the ID or name of the Location of the LoadBalancer. Either
+	// Location or NetworkZone must be set.
+	// +optional
+	Location *intstr.IntOrString `json:"location,omitempty"`
+
+	// +optional
+	NetworkZone *string `json:"network_zone,omitempty"`
+
+	// PublicInterface enables the public IPs of the LoadBalancer. It is
+	// enabled when omitted.
+	// +optional
+	PublicInterface *bool `json:"public_interface,omitempty"`
+
+	// Network is the ID of the private Network the LoadBalancer is attached to.
+	// +optional
+	Network *int `json:"network,omitempty"`
+
+	// NetworkIP is the IP of the LoadBalancer within the Network.
+	// +optional
+	NetworkIP *string `json:"network_ip,omitempty"`
+
+	// +optional
+	Services []LoadBalancerService `json:"services,omitempty"`
+
+	// +optional
+	Targets []LoadBalancerTarget `json:"targets,omitempty"`
+
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	Protection *Protection `json:"protection,omitempty"`
+}
+
+// LoadBalancerObservation are the observable fields of a LoadBalancer.
+type LoadBalancerObservation struct {
+	Id          int          `json:"id"`
+	Created     *metav1.Time `json:"created,omitempty"`
+	IPv4        string       `json:"ipv4"`
+	IPv6        string       `json:"ipv6"`
+	PrivateIPv4 string       `json:"privateIPv4,omitempty"`
+}
+
+// A LoadBalancerSpec defines the desired state of a LoadBalancer.
+type LoadBalancerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LoadBalancerParameters `json:"forProvider"`
+}
+
+// A LoadBalancerStatus represents the observed state of a LoadBalancer.
+type LoadBalancerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LoadBalancerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoadBalancer distributes traffic between Servers and other targets.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name",priority=1
+// +kubebuilder:printcolumn:name="IPv4",type="string",JSONPath=".status.atProvider.ipv4"
+// +kubebuilder:printcolumn:name="IPv6",type="string",JSONPath=".status.atProvider.ipv6",priority=10
+// +kubebuilder:printcolumn:name="PRIVATE-IPv4",type="string",JSONPath=".status.atProvider.privateIPv4",priority=10
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerSpec   `json:"spec"`
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerList contains a list of LoadBalancer
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
+
+// LoadBalancer type metadata.
+var (
+	LoadBalancerKind             = reflect.TypeOf(LoadBalancer{}).Name()
+	LoadBalancerGroupKind        = schema.GroupKind{Group: Group, Kind: LoadBalancerKind}.String()
+	LoadBalancerKindAPIVersion   = LoadBalancerKind + "." + SchemeGroupVersion.String()
+	LoadBalancerGroupVersionKind = SchemeGroupVersion.WithKind(LoadBalancerKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancer{}, &LoadBalancerList{})
+}
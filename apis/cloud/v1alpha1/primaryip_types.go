@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PrimaryIPParameters are the configurable fields of a PrimaryIP.
+type PrimaryIPParameters struct {
+	// +kubebuilder:validation:Enum=ipv4;ipv6
+	Type string `json:"type"`
+
+	// Datacenter is the name of the Datacenter the PrimaryIP is created in.
+	// It is required unless Assignee is set.
+	// +optional
+	Datacenter *string `json:"datacenter,omitempty"`
+
+	// Assignee is the ID of the Server the PrimaryIP is assigned to. Leave it
+	// empty when the Server refers to the PrimaryIP in its publicNet instead.
+	// Servers must be powered off for the assignment to change.
+	// +crossplane:generate:reference:type=Server
+	// +crossplane:generate:reference:extractor=ID()
+	// +optional
+	Assignee *string `json:"assignee,omitempty"`
+
+	// AssigneeRef references the Server the PrimaryIP is assigned to.
+	// +optional
+	AssigneeRef *xpv1.Reference `json:"assigneeRef,omitempty"`
+
+	// AssigneeSelector selects a reference to the Server the PrimaryIP is
+	// assigned to.
+	// +optional
+	AssigneeSelector *xpv1.Selector `json:"assigneeSelector,omitempty"`
+
+	// AutoDelete deletes the PrimaryIP together with the Server it is
+	// assigned to. Keep it disabled for addresses that outlive their Server.
+	// +optional
+	AutoDelete *bool `json:"autoDelete,omitempty"`
+
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	Protection *Protection `json:"protection,omitempty"`
+
+	// RDNS are the reverse DNS entries of the PrimaryIP. Addresses no longer
+	// listed keep their last entry.
+	// +optional
+	RDNS []ReverseDNS `json:"rdns,omitempty"`
+}
+
+// PrimaryIPObservation are the observable fields of a PrimaryIP.
+type PrimaryIPObservation struct {
+	Id         int          `json:"id"`
+	Created    *metav1.Time `json:"created,omitempty"`
+	IP         string       `json:"ip"`
+	AssigneeID *int         `json:"assigneeId,omitempty"`
+	Datacenter string       `json:"datacenter"`
+}
+
+// A PrimaryIPSpec defines the desired state of a PrimaryIP.
+type PrimaryIPSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PrimaryIPParameters `json:"forProvider"`
+}
+
+// A PrimaryIPStatus represents the observed state of a PrimaryIP.
+type PrimaryIPStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PrimaryIPObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PrimaryIP is a public IP address of a Server that can outlive it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name",priority=1
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ip"
+// +kubebuilder:printcolumn:name="ASSIGNEE",type="integer",JSONPath=".status.atProvider.assigneeId",priority=10
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
+type PrimaryIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PrimaryIPSpec   `json:"spec"`
+	Status PrimaryIPStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PrimaryIPList contains a list of PrimaryIP
+type PrimaryIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PrimaryIP `json:"items"`
+}
+
+// PrimaryIP type metadata.
+var (
+	PrimaryIPKind             = reflect.TypeOf(PrimaryIP{}).Name()
+	PrimaryIPGroupKind        = schema.GroupKind{Group: Group, Kind: PrimaryIPKind}.String()
+	PrimaryIPKindAPIVersion   = PrimaryIPKind + "." + SchemeGroupVersion.String()
+	PrimaryIPGroupVersionKind = SchemeGroupVersion.WithKind(PrimaryIPKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PrimaryIP{}, &PrimaryIPList{})
+}
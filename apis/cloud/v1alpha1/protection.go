@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// Protection guards a resource against accidental deletion. Protected
+// resources are not deleted along with their managed resource either.
+type Protection struct {
+	// +optional
+	Delete *bool `json:"delete,omitempty"`
+}
+
+// ServerProtection guards a Server against accidental deletion and rebuilds.
+// Hetzner requires both to be the same, an unset one follows the other.
+type ServerProtection struct {
+	// +optional
+	Delete *bool `json:"delete,omitempty"`
+
+	// +optional
+	Rebuild *bool `json:"rebuild,omitempty"`
+}
+
+// GetDelete returns the desired delete protection, nil if it is not managed.
+func (p *Protection) GetDelete() *bool {
+	if p == nil {
+		return nil
+	}
+	return p.Delete
+}
+
+// GetDelete returns the desired delete protection, nil if it is not managed.
+func (p *ServerProtection) GetDelete() *bool {
+	if p == nil {
+		return nil
+	}
+	if p.Delete == nil {
+		return p.Rebuild
+	}
+	return p.Delete
+}
+
+// GetRebuild returns the desired rebuild protection, nil if it is not
+// managed.
+func (p *ServerProtection) GetRebuild() *bool {
+	if p == nil {
+		return nil
+	}
+	if p.Rebuild == nil {
+		return p.Delete
+	}
+	return p.Rebuild
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// ReverseDNS is the reverse DNS (PTR) entry of a single IP address.
+type ReverseDNS struct {
+	// IP is the address the entry is set for. For IPv6 it may be any
+	// address within the assigned network.
+	IP string `json:"ip"`
+
+	// Hostname is the name the IP address resolves back to.
+	Hostname string `json:"hostname"`
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RouteParameters are the configurable fields of a Route.
+type RouteParameters struct {
+	// Network is the ID of the Network the Route is added to.
+	Network int `json:"network"`
+
+	// Destination is the network in CIDR notation that is routed. It
+	// identifies the Route within the Network.
+	Destination string `json:"destination"`
+
+	// Gateway is the IP address traffic to the Destination is sent to. It
+	// must lie within the range of the Network.
+	Gateway string `json:"gateway"`
+}
+
+// RouteObservation are the observable fields of a Route.
+type RouteObservation struct {
+	Destination string `json:"destination"`
+	Gateway     string `json:"gateway"`
+}
+
+// A RouteSpec defines the desired state of a Route.
+type RouteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RouteParameters `json:"forProvider"`
+}
+
+// A RouteStatus represents the observed state of a Route.
+type RouteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RouteObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Route sends the traffic of a Network destined to another network through
+// a gateway.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DESTINATION",type="string",JSONPath=".status.atProvider.destination"
+// +kubebuilder:printcolumn:name="GATEWAY",type="string",JSONPath=".status.atProvider.gateway"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
+type Route struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouteSpec   `json:"spec"`
+	Status RouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouteList contains a list of Route
+type RouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Route `json:"items"`
+}
+
+// Route type metadata.
+var (
+	RouteKind             = reflect.TypeOf(Route{}).Name()
+	RouteGroupKind        = schema.GroupKind{Group: Group, Kind: RouteKind}.String()
+	RouteKindAPIVersion   = RouteKind + "." + SchemeGroupVersion.String()
+	RouteGroupVersionKind = SchemeGroupVersion.WithKind(RouteKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Route{}, &RouteList{})
+}
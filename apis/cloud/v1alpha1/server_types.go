@@ -32,10 +32,89 @@ type PublicNetwork struct {
 	EnableIPv4 *bool `json:"enable_ipv4,omitempty"`
 	// +optional
 	EnableIPv6 *bool `json:"enable_ipv6,omitempty"`
+
+	// IPv4 is the ID of the PrimaryIP used as the public IPv4 address.
+	// Deprecated: Use IPv4ID, IPv4Ref or IPv4Selector instead.
 	// +optional
 	IPv4 *int `json:"ipv4,omitempty"`
+
+	// IPv4ID is the ID of the PrimaryIP used as the public IPv4 address.
+	// +crossplane:generate:reference:type=PrimaryIP
+	// +crossplane:generate:reference:extractor=ID()
+	// +crossplane:generate:reference:refFieldName=IPv4Ref
+	// +crossplane:generate:reference:selectorFieldName=IPv4Selector
+	// +optional
+	IPv4ID *string `json:"ipv4Id,omitempty"`
+
+	// IPv4Ref references the PrimaryIP used as the public IPv4 address.
+	// +optional
+	IPv4Ref *xpv1.Reference `json:"ipv4Ref,omitempty"`
+
+	// IPv4Selector selects a reference to the PrimaryIP used as the public
+	// IPv4 address.
+	// +optional
+	IPv4Selector *xpv1.Selector `json:"ipv4Selector,omitempty"`
+
+	// IPv6 is the ID of the PrimaryIP used as the public IPv6 network.
+	// Deprecated: Use IPv6ID, IPv6Ref or IPv6Selector instead.
 	// +optional
 	IPv6 *int `json:"ipv6,omitempty"`
+
+	// IPv6ID is the ID of the PrimaryIP used as the public IPv6 network.
+	// +crossplane:generate:reference:type=PrimaryIP
+	// +crossplane:generate:reference:extractor=ID()
+	// +crossplane:generate:reference:refFieldName=IPv6Ref
+	// +crossplane:generate:reference:selectorFieldName=IPv6Selector
+	// +optional
+	IPv6ID *string `json:"ipv6Id,omitempty"`
+
+	// IPv6Ref references the PrimaryIP used as the public IPv6 network.
+	// +optional
+	IPv6Ref *xpv1.Reference `json:"ipv6Ref,omitempty"`
+
+	// IPv6Selector selects a reference to the PrimaryIP used as the public
+	// IPv6 network.
+	// +optional
+	IPv6Selector *xpv1.Selector `json:"ipv6Selector,omitempty"`
+}
+
+// Rescale policies of a Server.
+const (
+	RescalePolicyRescale = "Rescale"
+	RescalePolicyReport  = "Report"
+)
+
+// Power states of a Server.
+const (
+	PowerStateOn  = "on"
+	PowerStateOff = "off"
+)
+
+// ServerPrivateNetwork describes how a Server is attached to a Network.
+type ServerPrivateNetwork struct {
+	// Network is the ID of the Network the Server is attached to.
+	// +crossplane:generate:reference:type=Network
+	// +crossplane:generate:reference:extractor=ID()
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references the Network the Server is attached to.
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to the Network the Server is
+	// attached to.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// IP is the static IP of the Server in the Network. Changing it detaches
+	// and re-attaches the Server. An IP is assigned automatically if unset.
+	// +optional
+	IP *string `json:"ip,omitempty"`
+
+	// AliasIPs are the additional IPs of the Server in the Network.
+	// +optional
+	AliasIPs []string `json:"aliasIPs,omitempty"`
 }
 
 // ServerParameters are the configurable fields of a Server.
@@ -43,44 +122,301 @@ type ServerParameters struct {
 	// ServerType is the ID or name of the Server type this Server should be created with
 	ServerType intstr.IntOrString `json:"serverType"`
 
-	// Image is the ID or name of the Image the Server is created from
-	Image intstr.IntOrString `json:"image"`
+	// RescalePolicy controls what happens when ServerType changes. Rescale
+	// powers the Server off, changes its type and powers it back on. Report
+	// only reports the drift through the ServerTypeDrift condition.
+	// +kubebuilder:validation:Enum=Rescale;Report
+	// +kubebuilder:default=Report
+	// +optional
+	RescalePolicy string `json:"rescalePolicy,omitempty"`
 
+	// UpgradeDisk grows the disk along with the Server type when rescaling.
+	// Servers with an upgraded disk cannot be downgraded again.
+	// +optional
+	UpgradeDisk *bool `json:"upgradeDisk,omitempty"`
+
+	// Image is the ID or name of the Image the Server is created from. It is
+	// ignored when ImageID is set or resolved.
+	// +optional
+	Image *intstr.IntOrString `json:"image,omitempty"`
+
+	// ImageID is the ID of the Image the Server is created from.
+	// +crossplane:generate:reference:type=Image
+	// +crossplane:generate:reference:extractor=ID()
+	// +crossplane:generate:reference:refFieldName=ImageRef
+	// +crossplane:generate:reference:selectorFieldName=ImageSelector
+	// +optional
+	ImageID *string `json:"imageId,omitempty"`
+
+	// ImageRef references the Image the Server is created from.
+	// +optional
+	ImageRef *xpv1.Reference `json:"imageRef,omitempty"`
+
+	// ImageSelector selects a reference to the Image the Server is created
+	// from.
+	// +optional
+	ImageSelector *xpv1.Selector `json:"imageSelector,omitempty"`
+
+	// RebuildOnImageChange rebuilds the Server from the new Image when Image
+	// changes, wiping its disk. Otherwise the Server is not synced while its
+	// Image differs.
+	// +optional
+	RebuildOnImageChange *bool `json:"rebuildOnImageChange,omitempty"`
+
+	// SSHKeys are the IDs or names of the SSHKeys authorized to log into the
+	// Server, in addition to SSHKeyIDs.
 	// +optional
 	SSHKeys *[]intstr.IntOrString `json:"sshKeys,omitempty"`
 
+	// SSHKeyIDs are the IDs of the SSHKeys authorized to log into the Server.
+	// +crossplane:generate:reference:type=SSHKey
+	// +crossplane:generate:reference:extractor=ID()
+	// +crossplane:generate:reference:refFieldName=SSHKeyRefs
+	// +crossplane:generate:reference:selectorFieldName=SSHKeySelector
+	// +optional
+	SSHKeyIDs []string `json:"sshKeyIds,omitempty"`
+
+	// SSHKeyRefs references the SSHKeys authorized to log into the Server.
+	// +optional
+	SSHKeyRefs []xpv1.Reference `json:"sshKeyRefs,omitempty"`
+
+	// SSHKeySelector selects references to the SSHKeys authorized to log into the Server.
+	// +optional
+	SSHKeySelector *xpv1.Selector `json:"sshKeySelector,omitempty"`
+
 	// +optional
 	Location *intstr.IntOrString `json:"location,omitempty"`
 
 	// +optional
 	Datacenter *intstr.IntOrString `json:"datacenter,omitempty"`
 
+	// UserData is the cloud-init user data the Server is created with. At
+	// most one of UserData, UserDataSecretRef and UserDataConfigMapRef may
+	// be set.
 	// +optional
 	UserData *string `json:"userData,omitempty"`
 
+	// UserDataSecretRef points to the user data in a Secret.
+	// +optional
+	UserDataSecretRef *xpv1.SecretKeySelector `json:"userDataSecretRef,omitempty"`
+
+	// UserDataConfigMapRef points to the user data in a ConfigMap.
+	// +optional
+	UserDataConfigMapRef *ConfigMapKeySelector `json:"userDataConfigMapRef,omitempty"`
+
+	// UserDataTemplate renders the user data as a Go template. It can refer
+	// to the .Name, .Labels, .Location and .Datacenter of the Server, its
+	// .PrivateNetworks with their .Network ID and .IP, and its .SSHKeys with
+	// their .ID, .Name, .Fingerprint and .PublicKey. Referenced SSHKeys are
+	// taken from their status. Only changes to the template itself count as
+	// changed user data, not changes to the values it refers to.
+	// +optional
+	UserDataTemplate *bool `json:"userDataTemplate,omitempty"`
+
+	// UserDataEncoding is the encoding the user data is sent in. GzipBase64
+	// compresses it to fit more into the 32 KiB Hetzner accepts.
+	// +kubebuilder:validation:Enum=None;GzipBase64
+	// +kubebuilder:default=None
+	// +optional
+	UserDataEncoding string `json:"userDataEncoding,omitempty"`
+
+	// RecreateOnUserDataChange deletes and recreates the Server when its
+	// user data changes, wiping its disk. Its primary IPs are kept. User data
+	// is only tracked while this is set, the user data at that time is
+	// assumed to be the one the Server was created with.
+	// +optional
+	RecreateOnUserDataChange *bool `json:"recreateOnUserDataChange,omitempty"`
+
 	// +optional
 	StartAfterCreate *bool `json:"startAfterCreate,omitempty"`
 
+	// PowerState is kept enforced once set. Servers are shut down gracefully
+	// and powered off hard once ShutdownTimeout has passed. It takes
+	// precedence over StartAfterCreate.
+	// +kubebuilder:validation:Enum=on;off
+	// +optional
+	PowerState *string `json:"powerState,omitempty"`
+
+	// ShutdownTimeout is how long a graceful shutdown may take before the
+	// Server is powered off hard. Defaults to 2m.
+	// +optional
+	ShutdownTimeout *metav1.Duration `json:"shutdownTimeout,omitempty"`
+
+	// RebootGeneration triggers a soft reboot of a running Server whenever it
+	// is increased.
+	// +optional
+	RebootGeneration *int64 `json:"rebootGeneration,omitempty"`
+
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
 
 	// +optional
 	Automount *bool `json:"automount,omitempty"`
 
+	// Volumes are the IDs of the Volumes attached to the Server.
+	// Deprecated: Use VolumeIDs, VolumeRefs or VolumeSelector instead.
 	// +optional
 	Volumes *[]int `json:"volumes,omitempty"`
 
+	// VolumeIDs are the IDs of the Volumes attached to the Server. Volumes
+	// that were attached from the spec are detached once they are removed
+	// from it, Volumes attached otherwise are left alone.
+	// +crossplane:generate:reference:type=Volume
+	// +crossplane:generate:reference:extractor=ID()
+	// +crossplane:generate:reference:refFieldName=VolumeRefs
+	// +crossplane:generate:reference:selectorFieldName=VolumeSelector
+	// +optional
+	VolumeIDs []string `json:"volumeIds,omitempty"`
+
+	// VolumeRefs references the Volumes attached to the Server.
+	// +optional
+	VolumeRefs []xpv1.Reference `json:"volumeRefs,omitempty"`
+
+	// VolumeSelector selects references to the Volumes attached to the Server.
+	// +optional
+	VolumeSelector *xpv1.Selector `json:"volumeSelector,omitempty"`
+
+	// Networks are the IDs of the Networks the Server is attached to.
+	// Deprecated: Use NetworkIDs, NetworkRefs or NetworkSelector instead.
 	// +optional
 	Networks *[]int `json:"networks,omitempty"`
 
+	// NetworkIDs are the IDs of the Networks the Server is attached to.
+	// Together with Networks and PrivateNetworks they replace any other
+	// attachments, unless all of them are left unset.
+	// +crossplane:generate:reference:type=Network
+	// +crossplane:generate:reference:extractor=ID()
+	// +crossplane:generate:reference:refFieldName=NetworkRefs
+	// +crossplane:generate:reference:selectorFieldName=NetworkSelector
+	// +optional
+	NetworkIDs []string `json:"networkIds,omitempty"`
+
+	// NetworkRefs references the Networks the Server is attached to.
+	// +optional
+	NetworkRefs []xpv1.Reference `json:"networkRefs,omitempty"`
+
+	// NetworkSelector selects references to the Networks the Server is attached to.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Firewalls are the IDs of the Firewalls applied to the Server.
+	// Deprecated: Use FirewallIDs, FirewallRefs or FirewallSelector instead.
 	// +optional
 	Firewalls *[]int `json:"firewalls,omitempty"`
 
+	// FirewallIDs are the IDs of the Firewalls applied to the Server.
+	// Firewalls that were applied from the spec are removed once they are
+	// removed from it, Firewalls applied otherwise are left alone.
+	// +crossplane:generate:reference:type=Firewall
+	// +crossplane:generate:reference:extractor=ID()
+	// +crossplane:generate:reference:refFieldName=FirewallRefs
+	// +crossplane:generate:reference:selectorFieldName=FirewallSelector
+	// +optional
+	FirewallIDs []string `json:"firewallIds,omitempty"`
+
+	// FirewallRefs references the Firewalls applied to the Server.
+	// +optional
+	FirewallRefs []xpv1.Reference `json:"firewallRefs,omitempty"`
+
+	// FirewallSelector selects references to the Firewalls applied to the Server.
+	// +optional
+	FirewallSelector *xpv1.Selector `json:"firewallSelector,omitempty"`
+
+	// PrivateNetworks attach the Server to Networks with a static IP or
+	// alias IPs.
+	// +optional
+	PrivateNetworks []ServerPrivateNetwork `json:"privateNetworks,omitempty"`
+
+	// PlacementGroup is the ID of the PlacementGroup the Server is part of.
+	// Deprecated: Use PlacementGroupID, PlacementGroupRef or
+	// PlacementGroupSelector instead.
 	// +optional
 	PlacementGroup *int `json:"placementGroup,omitempty"`
 
+	// PlacementGroupID is the ID of the PlacementGroup the Server is part
+	// of. The Server must be powered off for it to change.
+	// +crossplane:generate:reference:type=PlacementGroup
+	// +crossplane:generate:reference:extractor=ID()
+	// +crossplane:generate:reference:refFieldName=PlacementGroupRef
+	// +crossplane:generate:reference:selectorFieldName=PlacementGroupSelector
+	// +optional
+	PlacementGroupID *string `json:"placementGroupId,omitempty"`
+
+	// PlacementGroupRef references the PlacementGroup the Server is part of.
+	// +optional
+	PlacementGroupRef *xpv1.Reference `json:"placementGroupRef,omitempty"`
+
+	// PlacementGroupSelector selects a reference to the PlacementGroup the
+	// Server is part of.
+	// +optional
+	PlacementGroupSelector *xpv1.Selector `json:"placementGroupSelector,omitempty"`
+
 	// +optional
 	PublicNet *PublicNetwork `json:"publicNet,omitempty"`
+
+	// RDNS are the reverse DNS entries of the public IPs of the Server.
+	// Addresses no longer listed keep their last entry.
+	// +optional
+	RDNS []ReverseDNS `json:"rdns,omitempty"`
+
+	// +optional
+	Protection *ServerProtection `json:"protection,omitempty"`
+
+	// Backups enables daily backups of the Server when true and disables
+	// them when false. Disabling backups deletes all existing backups.
+	// +optional
+	Backups *bool `json:"backups,omitempty"`
+
+	// BackupWindow is the time window in UTC, e.g. "22-02", backups are
+	// created in. It is only applied when backups get enabled.
+	// +optional
+	BackupWindow *string `json:"backupWindow,omitempty"`
+
+	// Rescue boots a running Server into the rescue system while it is set.
+	// Clearing it boots the Server back into its own system. The root
+	// password of the rescue system is published as the rescuePassword
+	// connection detail.
+	// +optional
+	Rescue *ServerRescue `json:"rescue,omitempty"`
+
+	// ISO is the ID or name of an ISO that is attached to the Server while it
+	// is set. A running Server is reset to boot from it, and again once it is
+	// cleared and the ISO is detached.
+	// +optional
+	ISO *string `json:"iso,omitempty"`
+}
+
+// User data encodings.
+const (
+	UserDataEncodingNone       = "None"
+	UserDataEncodingGzipBase64 = "GzipBase64"
+)
+
+// ServerRescue configures the rescue system a Server is booted into.
+type ServerRescue struct {
+	// +kubebuilder:validation:Enum=linux64
+	// +kubebuilder:default=linux64
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// SSHKeys are the IDs or names of the SSHKeys authorized to log into
+	// the rescue system.
+	// +crossplane:generate:reference:type=SSHKey
+	// +crossplane:generate:reference:extractor=ID()
+	// +crossplane:generate:reference:refFieldName=SSHKeyRefs
+	// +crossplane:generate:reference:selectorFieldName=SSHKeySelector
+	// +optional
+	SSHKeys []string `json:"sshKeys,omitempty"`
+
+	// SSHKeyRefs references the SSHKeys authorized to log into the rescue
+	// system.
+	// +optional
+	SSHKeyRefs []xpv1.Reference `json:"sshKeyRefs,omitempty"`
+
+	// SSHKeySelector selects references to the SSHKeys authorized to log
+	// into the rescue system.
+	// +optional
+	SSHKeySelector *xpv1.Selector `json:"sshKeySelector,omitempty"`
 }
 
 // ServerObservation are the observable fields of a Server.
@@ -91,6 +427,85 @@ type ServerObservation struct {
 	DNS     string       `json:"dns"`
 	IPv4    string       `json:"ipv4"`
 	IPv6    string       `json:"ipv6"`
+
+	// ServerType is the name of the Server type the Server runs as.
+	// +optional
+	ServerType string `json:"serverType,omitempty"`
+
+	// Image is the name, or for snapshots the ID, of the Image the Server
+	// was created or last rebuilt from.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// RebootGeneration is the last RebootGeneration that was acted upon.
+	// +optional
+	RebootGeneration *int64 `json:"rebootGeneration,omitempty"`
+
+	// +optional
+	Firewalls []int `json:"firewalls,omitempty"`
+	// +optional
+	PrivateNetworks []ServerPrivateNetworkObservation `json:"privateNetworks,omitempty"`
+	// +optional
+	PlacementGroup *int `json:"placementGroup,omitempty"`
+	// +optional
+	Volumes []int `json:"volumes,omitempty"`
+
+	// ManagedFirewalls are the Firewalls the Server was attached to from its
+	// spec. They are detached once they are not in the spec anymore, unlike
+	// Firewalls the Server was attached to otherwise.
+	// +optional
+	ManagedFirewalls []int `json:"managedFirewalls,omitempty"`
+
+	// ManagedVolumes are the Volumes the Server was attached to from its
+	// spec. They are detached once they are not in the spec anymore, unlike
+	// Volumes the Server was attached to otherwise.
+	// +optional
+	ManagedVolumes []int `json:"managedVolumes,omitempty"`
+
+	// BackupWindow is the time window backups are created in, empty when
+	// backups are disabled.
+	// +optional
+	BackupWindow string `json:"backupWindow,omitempty"`
+
+	// Backups are the existing backups of the Server, latest first.
+	// +optional
+	Backups []ServerBackupObservation `json:"backups,omitempty"`
+
+	// Rescue is true while the Server was booted into the rescue system.
+	// +optional
+	Rescue bool `json:"rescue,omitempty"`
+
+	// ISO is the ID or name of the ISO that was attached to the Server.
+	// +optional
+	ISO string `json:"iso,omitempty"`
+
+	// UserDataHash is the SHA-256 hash of the user data the Server was
+	// created with. Templates are hashed before they are rendered.
+	// +optional
+	UserDataHash string `json:"userDataHash,omitempty"`
+
+	// PrimaryIPv4 is the ID of the IPv4 PrimaryIP of the Server.
+	// +optional
+	PrimaryIPv4 *int `json:"primaryIPv4,omitempty"`
+
+	// PrimaryIPv6 is the ID of the IPv6 PrimaryIP of the Server.
+	// +optional
+	PrimaryIPv6 *int `json:"primaryIPv6,omitempty"`
+}
+
+// ServerBackupObservation is an observed backup Image of a Server.
+type ServerBackupObservation struct {
+	ID          int          `json:"id"`
+	Description string       `json:"description,omitempty"`
+	Created     *metav1.Time `json:"created,omitempty"`
+}
+
+// ServerPrivateNetworkObservation is the observed attachment of a Server to
+// a Network.
+type ServerPrivateNetworkObservation struct {
+	Network  int      `json:"network"`
+	IP       string   `json:"ip"`
+	AliasIPs []string `json:"aliasIPs,omitempty"`
 }
 
 // A ServerSpec defines the desired state of a Server.
@@ -115,6 +530,7 @@ type ServerStatus struct {
 // +kubebuilder:printcolumn:name="DNS",type="string",JSONPath=".status.atProvider.dns",priority=10
 // +kubebuilder:printcolumn:name="IPv4",type="string",JSONPath=".status.atProvider.ipv4"
 // +kubebuilder:printcolumn:name="IPv6",type="string",JSONPath=".status.atProvider.ipv6",priority=10
+// +kubebuilder:printcolumn:name="LATEST-BACKUP",type="date",JSONPath=".status.atProvider.backups[0].created",priority=10
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
@@ -26,7 +26,17 @@ import (
 
 // SSHKeyParameters are the configurable fields of an SSHKey.
 type SSHKeyParameters struct {
-	PublicKey string `json:"publicKey"`
+	// PublicKey is the public key in OpenSSH authorized_keys format. When it
+	// is omitted a keypair is generated, and its private key, public key and
+	// fingerprint are published as connection details.
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+
+	// KeyType is the type of a generated keypair.
+	// +kubebuilder:validation:Enum=ed25519;rsa
+	// +kubebuilder:default=ed25519
+	// +optional
+	KeyType string `json:"keyType,omitempty"`
 
 	// +optional
 	Labels map[string]string `json:"labels"`
@@ -37,8 +47,22 @@ type SSHKeyObservation struct {
 	Id          int          `json:"id"`
 	Created     *metav1.Time `json:"created,omitempty"`
 	Fingerprint string       `json:"fingerprint"`
+
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+
+	// ReplacedID is the ID of the SSHKey a rotation replaced, as long as it
+	// was not deleted yet.
+	// +optional
+	ReplacedID int `json:"replacedId,omitempty"`
 }
 
+// Types of generated SSH keypairs.
+const (
+	SSHKeyTypeED25519 = "ed25519"
+	SSHKeyTypeRSA     = "rsa"
+)
+
 // A SSHKeySpec defines the desired state of a SSHKey.
 type SSHKeySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
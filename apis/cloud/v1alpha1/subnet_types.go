@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SubnetParameters are the configurable fields of a Subnet.
+type SubnetParameters struct {
+	// Network is the ID of the Network the Subnet is added to.
+	Network int `json:"network"`
+
+	// +kubebuilder:validation:Enum=cloud;server;vswitch
+	Type string `json:"type"`
+
+	// NetworkZone is the name of the Network Zone the Subnet is placed in,
+	// e.g. eu-central.
+	NetworkZone string `json:"networkZone"`
+
+	// IPRange is the range of the Subnet in CIDR notation. It must lie within
+	// the range of the Network and identifies the Subnet within it.
+	IPRange string `json:"ipRange"`
+
+	// VSwitchID is the ID of the Robot vSwitch to connect. It is required
+	// for Subnets of type vswitch.
+	// +optional
+	VSwitchID *int `json:"vSwitchId,omitempty"`
+}
+
+// SubnetObservation are the observable fields of a Subnet.
+type SubnetObservation struct {
+	IPRange string `json:"ipRange"`
+	Gateway string `json:"gateway"`
+}
+
+// A SubnetSpec defines the desired state of a Subnet.
+type SubnetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SubnetParameters `json:"forProvider"`
+}
+
+// A SubnetStatus represents the observed state of a Subnet.
+type SubnetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SubnetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Subnet is a range of IP addresses within a Network.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IP-RANGE",type="string",JSONPath=".status.atProvider.ipRange"
+// +kubebuilder:printcolumn:name="GATEWAY",type="string",JSONPath=".status.atProvider.gateway",priority=10
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
+type Subnet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubnetSpec   `json:"spec"`
+	Status SubnetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubnetList contains a list of Subnet
+type SubnetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subnet `json:"items"`
+}
+
+// Subnet type metadata.
+var (
+	SubnetKind             = reflect.TypeOf(Subnet{}).Name()
+	SubnetGroupKind        = schema.GroupKind{Group: Group, Kind: SubnetKind}.String()
+	SubnetKindAPIVersion   = SubnetKind + "." + SchemeGroupVersion.String()
+	SubnetGroupVersionKind = SchemeGroupVersion.WithKind(SubnetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Subnet{}, &SubnetList{})
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// VolumeParameters are the configurable fields of a Volume.
+type VolumeParameters struct {
+	// Size is the size of the Volume in GB. A Volume can only grow, so
+	// decreasing it has no effect.
+	// +kubebuilder:validation:Minimum=10
+	Size int `json:"size"`
+
+	// Format is the filesystem the Volume is formatted with on creation.
+	// +kubebuilder:validation:Enum=xfs;ext4
+	// +optional
+	Format *string `json:"format,omitempty"`
+
+	// Location is the ID or name of the Location the Volume is created in.
+	// It is required unless Server is set, in which case the Location of the
+	// Server is used.
+	// +optional
+	Location *intstr.IntOrString `json:"location,omitempty"`
+
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Automount mounts the Volume on the Server it is attached to.
+	// +optional
+	Automount *bool `json:"automount,omitempty"`
+
+	// Server is the ID of the Server the Volume is attached to.
+	// +optional
+	Server *int `json:"server,omitempty"`
+
+	// +optional
+	Protection *Protection `json:"protection,omitempty"`
+}
+
+// VolumeObservation are the observable fields of a Volume.
+type VolumeObservation struct {
+	Id          int          `json:"id"`
+	Created     *metav1.Time `json:"created,omitempty"`
+	Status      string       `json:"status"`
+	Size        int          `json:"size"`
+	Location    string       `json:"location"`
+	Server      *int         `json:"server,omitempty"`
+	LinuxDevice string       `json:"linuxDevice"`
+}
+
+// A VolumeSpec defines the desired state of a Volume.
+type VolumeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VolumeParameters `json:"forProvider"`
+}
+
+// A VolumeStatus represents the observed state of a Volume.
+type VolumeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VolumeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Volume is a block storage device that can be attached to a Server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name",priority=1
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".status.atProvider.size"
+// +kubebuilder:printcolumn:name="DEVICE",type="string",JSONPath=".status.atProvider.linuxDevice",priority=10
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,hetzner}
+type Volume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSpec   `json:"spec"`
+	Status VolumeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeList contains a list of Volume
+type VolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Volume `json:"items"`
+}
+
+// Volume type metadata.
+var (
+	VolumeKind             = reflect.TypeOf(Volume{}).Name()
+	VolumeGroupKind        = schema.GroupKind{Group: Group, Kind: VolumeKind}.String()
+	VolumeKindAPIVersion   = VolumeKind + "." + SchemeGroupVersion.String()
+	VolumeGroupVersionKind = SchemeGroupVersion.WithKind(VolumeKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Volume{}, &VolumeList{})
+}
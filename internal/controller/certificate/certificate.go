@@ -0,0 +1,285 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"context"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/yaskoo/provider-hetzner/apis/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/features"
+)
+
+const (
+	errNotCertificate = "managed resource is not a Certificate custom resource"
+	errTrackPCUsage   = "cannot track ProviderConfig usage"
+	errGetPC          = "cannot get ProviderConfig"
+	errGetCreds       = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errCertificate = "cannot get Certificate from Secret"
+	errPrivateKey  = "cannot get private key from Secret"
+	errCreateOpts  = "invalid Certificate parameters"
+	errRetryIssue  = "cannot retry Certificate issuance"
+)
+
+// A HCloudService is the interface to the Hetzner cloud API.
+type HCloudService struct {
+	client *hcloud.Client
+}
+
+var (
+	hCloudService = func(creds []byte) (*HCloudService, error) {
+		return &HCloudService{
+			client: hcloud.NewClient(hcloud.WithToken(string(creds))),
+		}, nil
+	}
+)
+
+// Setup adds a controller that reconciles Certificate managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CertificateGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CertificateGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: hCloudService}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Certificate{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (*HCloudService, error)
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return nil, errors.New(errNotCertificate)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{kube: c.kube, service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube client.Client
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service *HCloudService
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCertificate)
+	}
+
+	cert, _, err := c.service.client.Certificate.GetByName(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	exists := cert != nil && cert.ID > 0
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(availability(cert))
+	lastRetry := cr.Status.AtProvider.LastIssuanceRetry
+	cr.Status.AtProvider = toCertificateObservation(cert)
+	cr.Status.AtProvider.LastIssuanceRetry = lastRetry
+
+	// Certificates cannot be changed, so drift is only reported. The Secrets
+	// may be gone already while the Certificate is being deleted.
+	if !meta.WasDeleted(cr) {
+		field, err := c.drift(ctx, cr.Spec.ForProvider, cert)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if field != "" {
+			cr.Status.SetConditions(v1alpha1.CertificateDrifted(field))
+		} else {
+			cr.Status.SetConditions(v1alpha1.CertificateUpToDate())
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: util.LabelsUpToDate(cr.Spec.ForProvider.Labels, cert.Labels) &&
+			!issuanceRetryDue(cr.Status.AtProvider, time.Now()),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCertificate)
+	}
+
+	var pem, key []byte
+	if fp := cr.Spec.ForProvider; fp.CertificateSecretRef != nil && fp.PrivateKeySecretRef != nil {
+		var err error
+		if pem, err = util.GetSecretKey(ctx, c.kube, *fp.CertificateSecretRef); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCertificate)
+		}
+		if key, err = util.GetSecretKey(ctx, c.kube, *fp.PrivateKeySecretRef); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errPrivateKey)
+		}
+	}
+
+	opts := toCertificateCreateOpts(meta.GetExternalName(cr), cr.Spec.ForProvider, pem, key)
+	if err := opts.Validate(); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateOpts)
+	}
+
+	// Issuing a managed Certificate takes a while, Observe keeps track of it.
+	_, _, err := c.service.client.Certificate.CreateCertificate(ctx, opts)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, err
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCertificate)
+	}
+
+	labels := cr.Spec.ForProvider.Labels
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	cert := &hcloud.Certificate{ID: cr.Status.AtProvider.Id}
+	if _, _, err := c.service.client.Certificate.Update(ctx, cert, hcloud.CertificateUpdateOpts{
+		Labels: labels,
+	}); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if now := time.Now(); issuanceRetryDue(cr.Status.AtProvider, now) {
+		if _, _, err := c.service.client.Certificate.RetryIssuance(ctx, cert); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRetryIssue)
+		}
+		cr.Status.AtProvider.LastIssuanceRetry = &metav1.Time{Time: now}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return errors.New(errNotCertificate)
+	}
+
+	_, err := c.service.client.Certificate.Delete(ctx, &hcloud.Certificate{ID: cr.Status.AtProvider.Id})
+	return err
+}
+
+// drift returns the field the Certificate differs from the spec in, if any.
+// Hetzner does not return the private key, but it has to match the
+// certificate, so a new private key comes with a new certificate.
+func (c *external) drift(ctx context.Context, cp v1alpha1.CertificateParameters, cert *hcloud.Certificate) (string, error) {
+	if !typeUpToDate(cp.Type, cert.Type) {
+		return "type", nil
+	}
+
+	if cert.Type == hcloud.CertificateTypeManaged {
+		if !domainNamesUpToDate(cp.DomainNames, cert.DomainNames) {
+			return "domain names", nil
+		}
+		return "", nil
+	}
+
+	if cp.CertificateSecretRef == nil {
+		return "", nil
+	}
+	pem, err := util.GetSecretKey(ctx, c.kube, *cp.CertificateSecretRef)
+	if err != nil {
+		return "", errors.Wrap(err, errCertificate)
+	}
+	if !certificateUpToDate(pem, cert.Certificate) {
+		return "certificate", nil
+	}
+	return "", nil
+}
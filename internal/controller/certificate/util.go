@@ -0,0 +1,125 @@
+package certificate
+
+import (
+	"bytes"
+	"encoding/pem"
+	"sort"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func toCertificateCreateOpts(name string, cp v1alpha1.CertificateParameters, pem, key []byte) hcloud.CertificateCreateOpts {
+	opts := hcloud.CertificateCreateOpts{
+		Name:   name,
+		Type:   hcloud.CertificateType(cp.Type),
+		Labels: cp.Labels,
+	}
+
+	if opts.Type == hcloud.CertificateTypeManaged {
+		opts.DomainNames = cp.DomainNames
+	} else {
+		opts.Certificate = string(pem)
+		opts.PrivateKey = string(key)
+	}
+	return opts
+}
+
+func toCertificateObservation(cert *hcloud.Certificate) v1alpha1.CertificateObservation {
+	obs := v1alpha1.CertificateObservation{
+		Id:          cert.ID,
+		Created:     &metav1.Time{Time: cert.Created},
+		DomainNames: cert.DomainNames,
+		Fingerprint: cert.Fingerprint,
+	}
+
+	// Managed Certificates have no validity until they are issued.
+	if !cert.NotValidBefore.IsZero() {
+		obs.NotValidBefore = &metav1.Time{Time: cert.NotValidBefore}
+	}
+	if !cert.NotValidAfter.IsZero() {
+		obs.NotValidAfter = &metav1.Time{Time: cert.NotValidAfter}
+	}
+
+	if cert.Status != nil {
+		obs.Issuance = string(cert.Status.Issuance)
+		obs.Renewal = string(cert.Status.Renewal)
+		if cert.Status.Error != nil {
+			obs.Error = cert.Status.Error.Error()
+		}
+	}
+	return obs
+}
+
+// availability reports uploaded Certificates as available right away, while
+// managed ones only become available once they are issued.
+func availability(cert *hcloud.Certificate) xpv1.Condition {
+	if cert.Type != hcloud.CertificateTypeManaged || cert.Status == nil {
+		return xpv1.Available()
+	}
+
+	switch cert.Status.Issuance {
+	case hcloud.CertificateStatusTypeCompleted:
+		return xpv1.Available()
+	case hcloud.CertificateStatusTypeFailed:
+		c := xpv1.Unavailable()
+		if cert.Status.Error != nil {
+			c = c.WithMessage(cert.Status.Error.Error())
+		}
+		return c
+	default:
+		return xpv1.Creating()
+	}
+}
+
+// issuanceRetryInterval spaces out retries of a failed issuance, which
+// usually keeps failing until e.g. a DNS record is fixed.
+const issuanceRetryInterval = 15 * time.Minute
+
+// issuanceRetryDue reports whether the failed issuance of a managed
+// Certificate should be retried.
+func issuanceRetryDue(obs v1alpha1.CertificateObservation, now time.Time) bool {
+	if obs.Issuance != string(hcloud.CertificateStatusTypeFailed) {
+		return false
+	}
+	return obs.LastIssuanceRetry == nil || now.Sub(obs.LastIssuanceRetry.Time) >= issuanceRetryInterval
+}
+
+func typeUpToDate(desired string, actual hcloud.CertificateType) bool {
+	if desired == "" {
+		desired = v1alpha1.CertificateTypeUploaded
+	}
+	return desired == string(actual)
+}
+
+// domainNamesUpToDate compares the domain names regardless of their order.
+func domainNamesUpToDate(desired, actual []string) bool {
+	if len(desired) != len(actual) {
+		return false
+	}
+	d := append([]string(nil), desired...)
+	a := append([]string(nil), actual...)
+	sort.Strings(d)
+	sort.Strings(a)
+	for i := range d {
+		if d[i] != a[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// certificateUpToDate compares the leaf certificates, so that differences in
+// formatting do not count as drift.
+func certificateUpToDate(desired []byte, actual string) bool {
+	d, _ := pem.Decode(desired)
+	a, _ := pem.Decode([]byte(actual))
+	if d == nil || a == nil {
+		return bytes.Equal(bytes.TrimSpace(desired), bytes.TrimSpace([]byte(actual)))
+	}
+	return bytes.Equal(d.Bytes, a.Bytes)
+}
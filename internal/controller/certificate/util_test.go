@@ -0,0 +1,264 @@
+package certificate
+
+import (
+	"encoding/pem"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestToCertificateCreateOpts(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.CertificateParameters
+		want   hcloud.CertificateCreateOpts
+	}{
+		"Uploaded": {
+			reason: "An uploaded Certificate should carry the PEM data, but no domain names.",
+			params: v1alpha1.CertificateParameters{
+				Type:        v1alpha1.CertificateTypeUploaded,
+				DomainNames: []string{"example.com"},
+			},
+			want: hcloud.CertificateCreateOpts{
+				Name:        "cert",
+				Type:        hcloud.CertificateTypeUploaded,
+				Certificate: "pem",
+				PrivateKey:  "key",
+			},
+		},
+		"Managed": {
+			reason: "A managed Certificate should carry the domain names, but no PEM data.",
+			params: v1alpha1.CertificateParameters{
+				Type:        v1alpha1.CertificateTypeManaged,
+				DomainNames: []string{"example.com", "*.example.com"},
+			},
+			want: hcloud.CertificateCreateOpts{
+				Name:        "cert",
+				Type:        hcloud.CertificateTypeManaged,
+				DomainNames: []string{"example.com", "*.example.com"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := toCertificateCreateOpts("cert", tc.params, []byte("pem"), []byte("key"))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntoCertificateCreateOpts(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAvailability(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cert   *hcloud.Certificate
+		want   xpv1.Condition
+	}{
+		"Uploaded": {
+			reason: "An uploaded Certificate should be available right away.",
+			cert:   &hcloud.Certificate{Type: hcloud.CertificateTypeUploaded},
+			want:   xpv1.Available(),
+		},
+		"Pending": {
+			reason: "A managed Certificate should be creating until it is issued.",
+			cert: &hcloud.Certificate{
+				Type:   hcloud.CertificateTypeManaged,
+				Status: &hcloud.CertificateStatus{Issuance: hcloud.CertificateStatusTypePending},
+			},
+			want: xpv1.Creating(),
+		},
+		"Completed": {
+			reason: "A managed Certificate should be available once it is issued.",
+			cert: &hcloud.Certificate{
+				Type:   hcloud.CertificateTypeManaged,
+				Status: &hcloud.CertificateStatus{Issuance: hcloud.CertificateStatusTypeCompleted},
+			},
+			want: xpv1.Available(),
+		},
+		"Failed": {
+			reason: "A managed Certificate that failed to be issued should surface the error.",
+			cert: &hcloud.Certificate{
+				Type: hcloud.CertificateTypeManaged,
+				Status: &hcloud.CertificateStatus{
+					Issuance: hcloud.CertificateStatusTypeFailed,
+					Error:    &hcloud.Error{Code: "dns_zone_not_found", Message: "DNS zone not found"},
+				},
+			},
+			want: xpv1.Unavailable().WithMessage("DNS zone not found (dns_zone_not_found)"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := availability(tc.cert)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\navailability(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIssuanceRetryDue(t *testing.T) {
+	now := time.Now()
+	failed := string(hcloud.CertificateStatusTypeFailed)
+
+	cases := map[string]struct {
+		reason string
+		obs    v1alpha1.CertificateObservation
+		want   bool
+	}{
+		"Issued": {
+			reason: "An issued Certificate should not be retried.",
+			obs:    v1alpha1.CertificateObservation{Issuance: string(hcloud.CertificateStatusTypeCompleted)},
+		},
+		"NeverRetried": {
+			reason: "A failed issuance should be retried right away the first time.",
+			obs:    v1alpha1.CertificateObservation{Issuance: failed},
+			want:   true,
+		},
+		"RetriedRecently": {
+			reason: "A failed issuance should not be retried again within the retry interval.",
+			obs: v1alpha1.CertificateObservation{
+				Issuance:          failed,
+				LastIssuanceRetry: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+		},
+		"RetriedLongAgo": {
+			reason: "A failed issuance should be retried again once the retry interval passed.",
+			obs: v1alpha1.CertificateObservation{
+				Issuance:          failed,
+				LastIssuanceRetry: &metav1.Time{Time: now.Add(-issuanceRetryInterval)},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := issuanceRetryDue(tc.obs, now); got != tc.want {
+				t.Errorf("\n%s\nissuanceRetryDue(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTypeUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		desired string
+		actual  hcloud.CertificateType
+		want    bool
+	}{
+		"Defaulted": {
+			reason: "An unset type should be treated as uploaded.",
+			actual: hcloud.CertificateTypeUploaded,
+			want:   true,
+		},
+		"Same": {
+			reason:  "A Certificate of the desired type should be up to date.",
+			desired: v1alpha1.CertificateTypeManaged,
+			actual:  hcloud.CertificateTypeManaged,
+			want:    true,
+		},
+		"Different": {
+			reason:  "A Certificate of another type should drift.",
+			desired: v1alpha1.CertificateTypeManaged,
+			actual:  hcloud.CertificateTypeUploaded,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := typeUpToDate(tc.desired, tc.actual); got != tc.want {
+				t.Errorf("\n%s\ntypeUpToDate(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDomainNamesUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		desired []string
+		want    bool
+	}{
+		"Same": {
+			reason:  "The same domain names should be up to date.",
+			desired: []string{"example.com", "*.example.com"},
+			want:    true,
+		},
+		"Reordered": {
+			reason:  "The order of the domain names should not matter.",
+			desired: []string{"*.example.com", "example.com"},
+			want:    true,
+		},
+		"Added": {
+			reason:  "An additional domain name should drift.",
+			desired: []string{"example.com", "*.example.com", "example.org"},
+		},
+		"Replaced": {
+			reason:  "Another domain name should drift.",
+			desired: []string{"example.com", "example.org"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := domainNamesUpToDate(tc.desired, []string{"example.com", "*.example.com"}); got != tc.want {
+				t.Errorf("\n%s\ndomainNamesUpToDate(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCertificateUpToDate(t *testing.T) {
+	leaf := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("leaf")})
+	chain := append(append([]byte(nil), leaf...), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("intermediate")})...)
+
+	cases := map[string]struct {
+		reason  string
+		desired []byte
+		want    bool
+	}{
+		"Same": {
+			reason:  "The same certificate should be up to date.",
+			desired: leaf,
+			want:    true,
+		},
+		"Reformatted": {
+			reason:  "Whitespace around the certificate should not matter.",
+			desired: append([]byte("\n"), leaf...),
+			want:    true,
+		},
+		"Chain": {
+			reason:  "The leaf certificate should be compared, not the chain.",
+			desired: chain,
+			want:    true,
+		},
+		"Replaced": {
+			reason:  "Another certificate should drift.",
+			desired: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("other")}),
+		},
+		"Invalid": {
+			reason:  "Data that is not PEM encoded should drift.",
+			desired: []byte("garbage"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := certificateUpToDate(tc.desired, string(leaf)); got != tc.want {
+				t.Errorf("\n%s\ncertificateUpToDate(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
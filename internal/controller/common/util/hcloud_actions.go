@@ -0,0 +1,29 @@
+package util
+
+import (
+	"context"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+)
+
+// WaitForAction blocks until the given action has finished and returns the
+// error it failed with, if any.
+func WaitForAction(ctx context.Context, client *hcloud.Client, action *hcloud.Action) error {
+	if action == nil {
+		return nil
+	}
+
+	_, errCh := client.Action.WatchProgress(ctx, action)
+	return <-errCh
+}
+
+// WaitForActions blocks until all given actions have finished and returns the
+// first error one of them failed with, if any.
+func WaitForActions(ctx context.Context, client *hcloud.Client, actions []*hcloud.Action) error {
+	for _, action := range actions {
+		if err := WaitForAction(ctx, client, action); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package util
+
+import "net"
+
+// IPRangeUpToDate reports whether the desired CIDR denotes the same network as
+// the actual one.
+func IPRangeUpToDate(desired string, actual *net.IPNet) bool {
+	if actual == nil {
+		return desired == ""
+	}
+
+	_, ipNet, err := net.ParseCIDR(desired)
+	return err == nil && ipNet.String() == actual.String()
+}
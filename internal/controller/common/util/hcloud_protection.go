@@ -0,0 +1,45 @@
+package util
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+const errDeleteProtected = "cannot delete resource with delete protection enabled"
+
+// ProtectionUpToDate reports whether a protection matches the spec. Unset
+// protections are not managed.
+func ProtectionUpToDate(desired *bool, actual bool) bool {
+	return desired == nil || *desired == actual
+}
+
+// DeleteUnlessProtected deletes an external resource unless the spec protects
+// it, reporting the protection through a condition instead. Protection is
+// lifted when the spec explicitly disables it, as Update no longer runs once
+// the managed resource is being deleted.
+func DeleteUnlessProtected(mg resource.Conditioned, desired *bool, del, unprotect func() error) error {
+	if desired != nil && *desired {
+		return deleteProtected(mg)
+	}
+
+	err := del()
+	if !hcloud.IsError(err, hcloud.ErrorCodeProtected) {
+		return err
+	}
+	if desired == nil {
+		return deleteProtected(mg)
+	}
+
+	if err := unprotect(); err != nil {
+		return err
+	}
+	return del()
+}
+
+func deleteProtected(mg resource.Conditioned) error {
+	mg.SetConditions(v1alpha1.DeletionProtected())
+	return errors.New(errDeleteProtected)
+}
@@ -0,0 +1,63 @@
+package util
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+// DNSPtrLookup is implemented by the hcloud objects that have public IPs
+// with reverse DNS entries.
+type DNSPtrLookup interface {
+	GetDNSPtrForIP(ip net.IP) (string, error)
+}
+
+// DNSPtrChange is a reverse DNS entry that has to be set.
+type DNSPtrChange struct {
+	IP       net.IP
+	Hostname string
+}
+
+// DNSPtrChanges returns the desired reverse DNS entries that differ from the
+// actual ones. Every entry must address one of the given networks, nil
+// networks are ignored.
+func DNSPtrChanges(desired []v1alpha1.ReverseDNS, actual DNSPtrLookup, nets ...*net.IPNet) ([]DNSPtrChange, error) {
+	var changes []DNSPtrChange
+	for _, rdns := range desired {
+		ip := net.ParseIP(rdns.IP)
+		if ip == nil {
+			return nil, errors.Errorf("invalid reverse DNS IP address %q", rdns.IP)
+		}
+		if !containsIP(nets, ip) {
+			return nil, errors.Errorf("reverse DNS IP address %s is not assigned to the resource", rdns.IP)
+		}
+		// Addresses without an entry are reported as errors and count as unset.
+		if ptr, _ := actual.GetDNSPtrForIP(ip); ptr != rdns.Hostname {
+			changes = append(changes, DNSPtrChange{IP: ip, Hostname: rdns.Hostname})
+		}
+	}
+	return changes, nil
+}
+
+// HostNet returns the network containing only the given IP, or nil if the IP
+// is unset.
+func HostNet(ip net.IP) *net.IPNet {
+	if ip == nil || ip.IsUnspecified() {
+		return nil
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n != nil && n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
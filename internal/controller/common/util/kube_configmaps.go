@@ -0,0 +1,26 @@
+package util
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+// GetConfigMapKey returns the value of the key the selector points to.
+func GetConfigMapKey(ctx context.Context, kube client.Reader, sel v1alpha1.ConfigMapKeySelector) (string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: sel.Namespace, Name: sel.Name}, cm); err != nil {
+		return "", errors.Wrapf(err, "cannot get ConfigMap %s/%s", sel.Namespace, sel.Name)
+	}
+
+	v, ok := cm.Data[sel.Key]
+	if !ok {
+		return "", errors.Errorf("ConfigMap %s/%s has no key %q", sel.Namespace, sel.Name, sel.Key)
+	}
+	return v, nil
+}
@@ -0,0 +1,25 @@
+package util
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetSecretKey returns the value of the key the selector points to.
+func GetSecretKey(ctx context.Context, kube client.Reader, sel xpv1.SecretKeySelector) ([]byte, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: sel.Namespace, Name: sel.Name}, s); err != nil {
+		return nil, errors.Wrapf(err, "cannot get Secret %s/%s", sel.Namespace, sel.Name)
+	}
+
+	v, ok := s.Data[sel.Key]
+	if !ok {
+		return nil, errors.Errorf("Secret %s/%s has no key %q", sel.Namespace, sel.Name, sel.Key)
+	}
+	return v, nil
+}
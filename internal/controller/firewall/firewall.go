@@ -23,10 +23,15 @@ import (
 	"github.com/hetznercloud/hcloud-go/hcloud"
 	"github.com/pkg/errors"
 	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	"strings"
 
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -47,6 +52,17 @@ const (
 	errGetCreds     = "cannot get credentials"
 
 	errNewClient = "cannot create new Service"
+
+	errFirewallGone = "Firewall no longer exists"
+	errRules        = "invalid Firewall rules"
+	errSetRules     = "cannot set Firewall rules"
+	errApplyTo      = "cannot apply Firewall to its resources"
+
+	errCoveredServers = "cannot get Servers covered by Firewall"
+	errGetRuleSet     = "cannot get FirewallRuleSet"
+	errAttachments    = "cannot list FirewallAttachments"
+
+	errRuleSetsMissing = "referenced FirewallRuleSets do not exist"
 )
 
 // A HCloudService is the interface to the Hetzner cloud API.
@@ -85,9 +101,32 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.Firewall{}).
+		Watches(&source.Kind{Type: &v1alpha1.FirewallRuleSet{}}, handler.EnqueueRequestsFromMapFunc(firewallsForRuleSet(mgr.GetClient()))).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
 
+// firewallsForRuleSet maps a FirewallRuleSet to the Firewalls referencing it,
+// so that changes to its rules propagate to them.
+func firewallsForRuleSet(kube client.Client) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		l := &v1alpha1.FirewallList{}
+		if err := kube.List(context.Background(), l); err != nil {
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, wall := range l.Items {
+			for _, ref := range wall.Spec.ForProvider.RuleSetRefs {
+				if ref.Name == obj.GetName() {
+					requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: wall.GetName()}})
+					break
+				}
+			}
+		}
+		return requests
+	}
+}
+
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
@@ -127,7 +166,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{service: svc}, nil
+	return &external{service: svc, kube: c.kube}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -136,6 +175,7 @@ type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
 	service *HCloudService
+	kube    client.Client
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -145,19 +185,61 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	wall, _, err := c.service.client.Firewall.GetByName(ctx, meta.GetExternalName(cr))
-	exists := wall != nil && wall.ID > 0
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if wall == nil || wall.ID == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.Id = wall.ID
+	cr.Status.AtProvider.Created = &metav1.Time{Time: wall.Created}
+
+	cr.Status.AtProvider.AppliedTo = toAppliedToList(wall.AppliedTo)
+	if cr.Status.AtProvider.Servers, err = c.service.coveredServers(ctx, wall.ID); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCoveredServers)
+	}
 
-	if exists {
-		cr.Status.SetConditions(xpv1.Available())
-		cr.Status.AtProvider.Id = wall.ID
-		cr.Status.AtProvider.Created = &metav1.Time{Time: wall.Created}
+	// The rules are left alone while the Firewall is being deleted or one of
+	// its FirewallRuleSets is missing, rather than dropping the rules of the
+	// missing ones.
+	rulesUpToDate := true
+	if !meta.WasDeleted(cr) {
+		rules, missing, err := c.desiredRules(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if len(missing) > 0 {
+			cr.Status.SetConditions(v1alpha1.RuleSetsMissing(missing))
+		} else {
+			cr.Status.SetConditions(v1alpha1.RuleSetsResolved())
+			if rulesUpToDate, err = firewallRulesUpToDate(rules, wall.Rules); err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errRules)
+			}
+		}
+	}
+
+	desired, err := toFirewallResources(cr.Spec.ForProvider.ApplyTo)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errApplyTo)
 	}
+	// Firewalls created or applied before this was tracked are assumed to be
+	// applied to the desired resources from their spec.
+	if cr.Status.AtProvider.ManagedAppliedTo == nil {
+		cr.Status.AtProvider.ManagedAppliedTo = managedAppliedTo(desired, wall.AppliedTo)
+	}
+	attached, err := c.attachedResources(ctx, wall.ID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errAttachments)
+	}
+	d := diffAppliedTo(desired, wall.AppliedTo, cr.Status.AtProvider.ManagedAppliedTo, attached)
 
 	return managed.ExternalObservation{
-		ResourceExists:    exists,
-		ResourceUpToDate:  exists && util.LabelsUpToDate(cr.Spec.ForProvider.Labels, wall.Labels),
+		ResourceExists:    true,
+		ResourceUpToDate:  rulesUpToDate && d.empty() && util.LabelsUpToDate(cr.Spec.ForProvider.Labels, wall.Labels),
 		ConnectionDetails: managed.ConnectionDetails{},
-	}, err
+	}, nil
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -171,7 +253,16 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		Labels: cr.Spec.ForProvider.Labels,
 	}
 
-	rules, err := toFirewallRules(cr.Spec.ForProvider.Rules)
+	desiredRules, missing, err := c.desiredRules(ctx, cr)
+	if err == nil && len(missing) > 0 {
+		err = errors.Errorf("%s: %s", errRuleSetsMissing, strings.Join(missing, ", "))
+	}
+	if err != nil {
+		return managed.ExternalCreation{
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, err
+	}
+	rules, err := toFirewallRules(desiredRules)
 	if err != nil {
 		return managed.ExternalCreation{
 			ConnectionDetails: managed.ConnectionDetails{},
@@ -204,10 +295,122 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		ID: cr.Status.AtProvider.Id,
 	}
 
-	_, _, err := c.service.client.Firewall.Update(ctx, firewall, hcloud.FirewallUpdateOpts{
+	if _, _, err := c.service.client.Firewall.Update(ctx, firewall, hcloud.FirewallUpdateOpts{
 		Labels: cr.Spec.ForProvider.Labels,
-	})
-	return managed.ExternalUpdate{}, err
+	}); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	wall, _, err := c.service.client.Firewall.GetByID(ctx, firewall.ID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if wall == nil {
+		return managed.ExternalUpdate{}, errors.New(errFirewallGone)
+	}
+
+	rules, missing, err := c.desiredRules(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if len(missing) == 0 {
+		if err := c.updateRules(ctx, wall, rules); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetRules)
+		}
+	}
+	if err := c.updateAppliedTo(ctx, wall, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errApplyTo)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// desiredRules returns the inline rules of the Firewall followed by the rules
+// of the FirewallRuleSets it references, without duplicates, and the names of
+// the referenced FirewallRuleSets that do not exist.
+func (c *external) desiredRules(ctx context.Context, cr *v1alpha1.Firewall) ([]v1alpha1.FirewallRule, []string, error) {
+	rules := append([]v1alpha1.FirewallRule{}, cr.Spec.ForProvider.Rules...)
+	var missing []string
+	for _, ref := range cr.Spec.ForProvider.RuleSetRefs {
+		rs := &v1alpha1.FirewallRuleSet{}
+		if err := c.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, rs); err != nil {
+			if kerrors.IsNotFound(err) {
+				missing = append(missing, ref.Name)
+				continue
+			}
+			return nil, nil, errors.Wrap(err, errGetRuleSet)
+		}
+		rules = append(rules, rs.Spec.Rules...)
+	}
+
+	rules, err := dedupeRules(rules)
+	return rules, missing, errors.Wrap(err, errRules)
+}
+
+// updateRules sets the rules of the Firewall. Setting the rules replaces all
+// of them, which is only done when they differ, as the Firewall is reapplied
+// to all of its resources.
+func (c *external) updateRules(ctx context.Context, wall *hcloud.Firewall, desired []v1alpha1.FirewallRule) error {
+	rulesUpToDate, err := firewallRulesUpToDate(desired, wall.Rules)
+	if err != nil || rulesUpToDate {
+		return err
+	}
+
+	rules, err := toFirewallRules(desired)
+	if err != nil {
+		return err
+	}
+	actions, _, err := c.service.client.Firewall.SetRules(ctx, wall, hcloud.FirewallSetRulesOpts{Rules: rules})
+	if err != nil {
+		return err
+	}
+	return util.WaitForActions(ctx, c.service.client, actions)
+}
+
+// attachedResources returns the keys of the resources FirewallAttachments
+// attach the Firewall to.
+func (c *external) attachedResources(ctx context.Context, id int) (map[string]bool, error) {
+	l := &v1alpha1.FirewallAttachmentList{}
+	if err := c.kube.List(ctx, l); err != nil {
+		return nil, err
+	}
+	return attachedResources(l.Items, id), nil
+}
+
+// updateAppliedTo applies the Firewall to the desired resources and removes it
+// from the ones that were removed from its spec, unless a FirewallAttachment
+// attaches the Firewall to them.
+func (c *external) updateAppliedTo(ctx context.Context, wall *hcloud.Firewall, cr *v1alpha1.Firewall) error {
+	desired, err := toFirewallResources(cr.Spec.ForProvider.ApplyTo)
+	if err != nil {
+		return err
+	}
+
+	attached, err := c.attachedResources(ctx, wall.ID)
+	if err != nil {
+		return errors.Wrap(err, errAttachments)
+	}
+	d := diffAppliedTo(desired, wall.AppliedTo, cr.Status.AtProvider.ManagedAppliedTo, attached)
+	if len(d.apply) > 0 {
+		actions, _, err := c.service.client.Firewall.ApplyResources(ctx, wall, d.apply)
+		if err == nil {
+			err = util.WaitForActions(ctx, c.service.client, actions)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if len(d.remove) > 0 {
+		actions, _, err := c.service.client.Firewall.RemoveResources(ctx, wall, d.remove)
+		if err == nil {
+			err = util.WaitForActions(ctx, c.service.client, actions)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	cr.Status.AtProvider.ManagedAppliedTo = toAppliedToList(desired)
+	return nil
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
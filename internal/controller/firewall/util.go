@@ -1,19 +1,29 @@
 package firewall
 
 import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/hetznercloud/hcloud-go/hcloud/schema"
 	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
-	"net"
 )
 
-func toIPNets(cidrs []string) ([]net.IPNet, error) {
+func toIPNets(cidrs v1alpha1.CIDRs) ([]net.IPNet, error) {
 	if cidrs == nil || len(cidrs) == 0 {
 		return nil, nil
 	}
 
 	parsed := make([]net.IPNet, len(cidrs))
 	for idx, cidr := range cidrs {
-		_, ipNet, err := net.ParseCIDR(cidr)
+		// Host bits are rejected on admission, the ones of CIDRs stored
+		// before are masked.
+		_, ipNet, err := net.ParseCIDR(string(cidr))
 		if err != nil {
 			return nil, err
 		}
@@ -52,6 +62,122 @@ func toFirewallRules(rules []v1alpha1.FirewallRule) ([]hcloud.FirewallRule, erro
 	return mapped, nil
 }
 
+// firewallRulesUpToDate reports whether the desired rules are the actual
+// ones, regardless of their order.
+func firewallRulesUpToDate(desired []v1alpha1.FirewallRule, actual []hcloud.FirewallRule) (bool, error) {
+	rules, err := toFirewallRules(desired)
+	if err != nil {
+		return false, err
+	}
+
+	want, got := normalizeRules(rules), normalizeRules(actual)
+	if len(want) != len(got) {
+		return false, nil
+	}
+	for i := range want {
+		if want[i] != got[i] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// dedupeRules returns the rules without the ones identical to an earlier rule
+// once normalized.
+func dedupeRules(rules []v1alpha1.FirewallRule) ([]v1alpha1.FirewallRule, error) {
+	mapped, err := toFirewallRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[normalizedRule]bool, len(mapped))
+	deduped := make([]v1alpha1.FirewallRule, 0, len(rules))
+	for idx, rule := range mapped {
+		n := normalizeRules([]hcloud.FirewallRule{rule})[0]
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		deduped = append(deduped, rules[idx])
+	}
+	return deduped, nil
+}
+
+// normalizedRule is a FirewallRule in a canonical, comparable form.
+type normalizedRule struct {
+	direction   string
+	protocol    string
+	port        string
+	description string
+	ips         string
+}
+
+// normalizeRules returns the rules in canonical form, sorted. Only the IPs
+// matching the direction of a rule are considered, as Hetzner ignores the
+// others, and both nil and empty descriptions and ports are the same.
+func normalizeRules(rules []hcloud.FirewallRule) []normalizedRule {
+	normalized := make([]normalizedRule, 0, len(rules))
+	for _, rule := range rules {
+		ips := rule.SourceIPs
+		if rule.Direction == hcloud.FirewallRuleDirectionOut {
+			ips = rule.DestinationIPs
+		}
+		n := normalizedRule{
+			direction: string(rule.Direction),
+			protocol:  string(rule.Protocol),
+			ips:       normalizeIPNets(ips),
+		}
+		if rule.Port != nil {
+			n.port = normalizePort(*rule.Port)
+		}
+		if rule.Description != nil {
+			n.description = *rule.Description
+		}
+		normalized = append(normalized, n)
+	}
+
+	sort.Slice(normalized, func(i, j int) bool {
+		a, b := normalized[i], normalized[j]
+		switch {
+		case a.direction != b.direction:
+			return a.direction < b.direction
+		case a.protocol != b.protocol:
+			return a.protocol < b.protocol
+		case a.port != b.port:
+			return a.port < b.port
+		case a.ips != b.ips:
+			return a.ips < b.ips
+		}
+		return a.description < b.description
+	})
+	return normalized
+}
+
+// normalizeIPNets returns the canonical CIDRs of the networks, sorted and
+// joined.
+func normalizeIPNets(ipNets []net.IPNet) string {
+	cidrs := make([]string, 0, len(ipNets))
+	for _, ipNet := range ipNets {
+		ip := ipNet.IP.Mask(ipNet.Mask)
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+		}
+		cidrs = append(cidrs, (&net.IPNet{IP: ip, Mask: ipNet.Mask}).String())
+	}
+	sort.Strings(cidrs)
+	return strings.Join(cidrs, ",")
+}
+
+// normalizePort formats a port or port range the way Hetzner does, with a
+// range of a single port being just the port.
+func normalizePort(port string) string {
+	port = strings.ReplaceAll(port, " ", "")
+	if from, to, ok := strings.Cut(port, "-"); ok && from == to {
+		return from
+	}
+	return port
+}
+
 func toFirewallResources(fromSpec []v1alpha1.FirewallResource) ([]hcloud.FirewallResource, error) {
 	if fromSpec == nil || len(fromSpec) == 0 {
 		return nil, nil
@@ -70,11 +196,194 @@ func toFirewallResources(fromSpec []v1alpha1.FirewallResource) ([]hcloud.Firewal
 		}
 
 		if res.Server != nil {
+			id, err := strconv.Atoi(*res.Server)
+			if err != nil {
+				return nil, err
+			}
 			firewallResource.Server = &hcloud.FirewallResourceServer{
-				ID: *res.Server,
+				ID: id,
 			}
 		}
 		mapped[idx] = firewallResource
 	}
 	return mapped, nil
 }
+
+// toAppliedTo returns the observed form of a resource a Firewall is applied
+// to.
+func toAppliedTo(res hcloud.FirewallResource) v1alpha1.FirewallAppliedTo {
+	applied := v1alpha1.FirewallAppliedTo{Type: string(res.Type)}
+	if res.Server != nil {
+		id := res.Server.ID
+		applied.Server = &id
+	}
+	if res.LabelSelector != nil {
+		applied.LabelSelector = res.LabelSelector.Selector
+	}
+	return applied
+}
+
+func toAppliedToList(resources []hcloud.FirewallResource) []v1alpha1.FirewallAppliedTo {
+	if len(resources) == 0 {
+		return nil
+	}
+	applied := make([]v1alpha1.FirewallAppliedTo, 0, len(resources))
+	for _, res := range resources {
+		applied = append(applied, toAppliedTo(res))
+	}
+	return applied
+}
+
+func fromAppliedTo(applied v1alpha1.FirewallAppliedTo) hcloud.FirewallResource {
+	res := hcloud.FirewallResource{Type: hcloud.FirewallResourceType(applied.Type)}
+	if applied.Server != nil {
+		res.Server = &hcloud.FirewallResourceServer{ID: *applied.Server}
+	}
+	if applied.LabelSelector != "" {
+		res.LabelSelector = &hcloud.FirewallResourceLabelSelector{Selector: applied.LabelSelector}
+	}
+	return res
+}
+
+// resourceKey identifies a resource a Firewall is applied to.
+func resourceKey(res hcloud.FirewallResource) string {
+	switch {
+	case res.Server != nil:
+		return fmt.Sprintf("%s:%d", res.Type, res.Server.ID)
+	case res.LabelSelector != nil:
+		return fmt.Sprintf("%s:%s", res.Type, res.LabelSelector.Selector)
+	}
+	return string(res.Type)
+}
+
+// appliedToDiff are the resources a Firewall has to be applied to and
+// removed from.
+type appliedToDiff struct {
+	apply  []hcloud.FirewallResource
+	remove []hcloud.FirewallResource
+}
+
+func (d appliedToDiff) empty() bool {
+	return len(d.apply) == 0 && len(d.remove) == 0
+}
+
+// diffAppliedTo returns the desired resources the Firewall is not applied to
+// yet, and the resources it was applied to from its spec that are not
+// desired anymore. Resources the Firewall was applied to otherwise, e.g. by
+// FirewallAttachments or Servers, are left alone.
+func diffAppliedTo(desired, actual []hcloud.FirewallResource, managed []v1alpha1.FirewallAppliedTo, attached map[string]bool) appliedToDiff {
+	want := make(map[string]bool, len(desired))
+	for _, res := range desired {
+		want[resourceKey(res)] = true
+	}
+	has := make(map[string]bool, len(actual))
+	for _, res := range actual {
+		has[resourceKey(res)] = true
+	}
+
+	var d appliedToDiff
+	for _, res := range desired {
+		if !has[resourceKey(res)] {
+			d.apply = append(d.apply, res)
+		}
+	}
+	for _, applied := range managed {
+		res := fromAppliedTo(applied)
+		if key := resourceKey(res); has[key] && !want[key] && !attached[key] {
+			d.remove = append(d.remove, res)
+		}
+	}
+	return d
+}
+
+// attachedResources returns the keys of the resources the FirewallAttachments
+// of the Firewall attach it to, by their spec or by what they applied.
+// Attachments that are being deleted are left out.
+func attachedResources(attachments []v1alpha1.FirewallAttachment, id int) map[string]bool {
+	keys := make(map[string]bool)
+	for i := range attachments {
+		a := &attachments[i]
+		p := a.Spec.ForProvider
+		if meta.WasDeleted(a) || (a.Status.AtProvider.Firewall != id && (p.Firewall == nil || *p.Firewall != strconv.Itoa(id))) {
+			continue
+		}
+		switch {
+		case p.LabelSelector != nil:
+			keys[resourceKey(hcloud.FirewallResource{
+				Type:          hcloud.FirewallResourceTypeLabelSelector,
+				LabelSelector: &hcloud.FirewallResourceLabelSelector{Selector: *p.LabelSelector},
+			})] = true
+		case p.Server != nil:
+			if server, err := strconv.Atoi(*p.Server); err == nil {
+				keys[resourceKey(hcloud.FirewallResource{
+					Type:   hcloud.FirewallResourceTypeServer,
+					Server: &hcloud.FirewallResourceServer{ID: server},
+				})] = true
+			}
+		}
+		if applied := a.Status.AtProvider.Attached; applied != nil {
+			keys[resourceKey(fromAppliedTo(*applied))] = true
+		}
+	}
+	return keys
+}
+
+// managedAppliedTo returns the desired resources the Firewall is applied to,
+// which are the ones it was applied to from its spec.
+func managedAppliedTo(desired, actual []hcloud.FirewallResource) []v1alpha1.FirewallAppliedTo {
+	has := make(map[string]bool, len(actual))
+	for _, res := range actual {
+		has[resourceKey(res)] = true
+	}
+	var managed []v1alpha1.FirewallAppliedTo
+	for _, res := range desired {
+		if has[resourceKey(res)] {
+			managed = append(managed, toAppliedTo(res))
+		}
+	}
+	return managed
+}
+
+// firewallResponse is the part of a Firewall response hcloud-go drops: the
+// Servers the label selectors of a Firewall match.
+type firewallResponse struct {
+	Firewall struct {
+		AppliedTo []struct {
+			Server             *schema.FirewallResourceServer `json:"server"`
+			AppliedToResources []struct {
+				Server *schema.FirewallResourceServer `json:"server"`
+			} `json:"applied_to_resources"`
+		} `json:"applied_to"`
+	} `json:"firewall"`
+}
+
+// coveredServers returns the sorted IDs of the Servers a Firewall covers,
+// directly or through label selectors.
+func (s *HCloudService) coveredServers(ctx context.Context, id int) ([]int, error) {
+	req, err := s.client.NewRequest(ctx, "GET", fmt.Sprintf("/firewalls/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var respBody firewallResponse
+	if _, err := s.client.Do(req, &respBody); err != nil {
+		return nil, err
+	}
+
+	seen := map[int]bool{}
+	var servers []int
+	add := func(server *schema.FirewallResourceServer) {
+		if server != nil && !seen[server.ID] {
+			seen[server.ID] = true
+			servers = append(servers, server.ID)
+		}
+	}
+	for _, applied := range respBody.Firewall.AppliedTo {
+		add(applied.Server)
+		for _, res := range applied.AppliedToResources {
+			add(res.Server)
+		}
+	}
+	sort.Ints(servers)
+	return servers, nil
+}
@@ -0,0 +1,301 @@
+package firewall
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestFirewallRulesUpToDate(t *testing.T) {
+	cidr := func(s string) net.IPNet {
+		_, ipNet, _ := net.ParseCIDR(s)
+		return *ipNet
+	}
+	actual := []hcloud.FirewallRule{
+		{
+			Direction: hcloud.FirewallRuleDirectionIn,
+			Protocol:  hcloud.FirewallRuleProtocolTCP,
+			Port:      hcloud.Ptr("80"),
+			SourceIPs: []net.IPNet{cidr("0.0.0.0/0"), cidr("::/0")},
+		},
+		{
+			Direction:   hcloud.FirewallRuleDirectionIn,
+			Protocol:    hcloud.FirewallRuleProtocolTCP,
+			Port:        hcloud.Ptr("8000-8080"),
+			SourceIPs:   []net.IPNet{cidr("10.0.0.0/8")},
+			Description: hcloud.Ptr("internal"),
+		},
+		{
+			Direction:      hcloud.FirewallRuleDirectionOut,
+			Protocol:       hcloud.FirewallRuleProtocolICMP,
+			DestinationIPs: []net.IPNet{cidr("0.0.0.0/0")},
+			Description:    hcloud.Ptr(""),
+		},
+	}
+
+	cases := map[string]struct {
+		reason  string
+		desired []v1alpha1.FirewallRule
+		want    bool
+		wantErr bool
+	}{
+		"Normalized": {
+			reason: "Rules are the same regardless of order, CIDR notation, port ranges of one port and unset descriptions.",
+			desired: []v1alpha1.FirewallRule{
+				{Direction: "out", Protocol: "icmp", DestinationIPs: v1alpha1.CIDRs{"0.0.0.0/0"}},
+				{Direction: "in", Protocol: "tcp", Port: hcloud.Ptr("80-80"), SourceIPs: v1alpha1.CIDRs{"::/0", "0.0.0.0/0"}},
+				{Direction: "in", Protocol: "tcp", Port: hcloud.Ptr("8000-8080"), SourceIPs: v1alpha1.CIDRs{"10.1.2.3/8"}, Description: hcloud.Ptr("internal")},
+			},
+			want: true,
+		},
+		"Changed": {
+			reason: "A changed port is drift.",
+			desired: []v1alpha1.FirewallRule{
+				{Direction: "out", Protocol: "icmp", DestinationIPs: v1alpha1.CIDRs{"0.0.0.0/0"}},
+				{Direction: "in", Protocol: "tcp", Port: hcloud.Ptr("443"), SourceIPs: v1alpha1.CIDRs{"0.0.0.0/0", "::/0"}},
+				{Direction: "in", Protocol: "tcp", Port: hcloud.Ptr("8000-8080"), SourceIPs: v1alpha1.CIDRs{"10.0.0.0/8"}, Description: hcloud.Ptr("internal")},
+			},
+			want: false,
+		},
+		"Removed": {
+			reason: "A rule that was added out of band is drift.",
+			desired: []v1alpha1.FirewallRule{
+				{Direction: "out", Protocol: "icmp", DestinationIPs: v1alpha1.CIDRs{"0.0.0.0/0"}},
+				{Direction: "in", Protocol: "tcp", Port: hcloud.Ptr("80"), SourceIPs: v1alpha1.CIDRs{"0.0.0.0/0", "::/0"}},
+			},
+			want: false,
+		},
+		"Invalid": {
+			reason:  "Invalid CIDRs are an error.",
+			desired: []v1alpha1.FirewallRule{{Direction: "in", Protocol: "icmp", SourceIPs: v1alpha1.CIDRs{"10.0.0.1"}}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := firewallRulesUpToDate(tc.desired, actual)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\nfirewallRulesUpToDate(...): want error %t, got %v\n", tc.reason, tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("\n%s\nfirewallRulesUpToDate(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDedupeRules(t *testing.T) {
+	office := v1alpha1.FirewallRule{Direction: "in", Protocol: "tcp", Port: hcloud.Ptr("22"), SourceIPs: v1alpha1.CIDRs{"10.0.0.0/8"}}
+
+	cases := map[string]struct {
+		reason  string
+		rules   []v1alpha1.FirewallRule
+		want    []v1alpha1.FirewallRule
+		wantErr bool
+	}{
+		"Empty": {
+			reason: "No rules are no rules.",
+			want:   []v1alpha1.FirewallRule{},
+		},
+		"Identical": {
+			reason: "Identical rules are only kept once, in the order they first appear.",
+			rules: []v1alpha1.FirewallRule{
+				office,
+				{Direction: "out", Protocol: "icmp", DestinationIPs: v1alpha1.CIDRs{"0.0.0.0/0"}},
+				office,
+			},
+			want: []v1alpha1.FirewallRule{
+				office,
+				{Direction: "out", Protocol: "icmp", DestinationIPs: v1alpha1.CIDRs{"0.0.0.0/0"}},
+			},
+		},
+		"Normalized": {
+			reason: "Rules that are only written differently are identical.",
+			rules: []v1alpha1.FirewallRule{
+				office,
+				{Direction: "in", Protocol: "tcp", Port: hcloud.Ptr("22-22"), SourceIPs: v1alpha1.CIDRs{"10.0.0.0/8"}, Description: hcloud.Ptr("")},
+			},
+			want: []v1alpha1.FirewallRule{office},
+		},
+		"Different": {
+			reason: "Rules differing in their description are kept.",
+			rules: []v1alpha1.FirewallRule{
+				office,
+				{Direction: "in", Protocol: "tcp", Port: hcloud.Ptr("22"), SourceIPs: v1alpha1.CIDRs{"10.0.0.0/8"}, Description: hcloud.Ptr("vpn")},
+			},
+			want: []v1alpha1.FirewallRule{
+				office,
+				{Direction: "in", Protocol: "tcp", Port: hcloud.Ptr("22"), SourceIPs: v1alpha1.CIDRs{"10.0.0.0/8"}, Description: hcloud.Ptr("vpn")},
+			},
+		},
+		"Invalid": {
+			reason:  "Rules with invalid CIDRs are rejected.",
+			rules:   []v1alpha1.FirewallRule{{Direction: "in", Protocol: "icmp", SourceIPs: v1alpha1.CIDRs{"10.0.0.1"}}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := dedupeRules(tc.rules)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\ndedupeRules(...): want error %t, got %v\n", tc.reason, tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ndedupeRules(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestToIPNets(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		cidrs   v1alpha1.CIDRs
+		want    []string
+		wantErr bool
+	}{
+		"Empty": {
+			reason: "No CIDRs are no IP nets.",
+		},
+		"Valid": {
+			reason: "CIDRs without host bits are parsed as is.",
+			cidrs:  v1alpha1.CIDRs{"10.0.0.0/8", "93.123.21.124/32", "2001:db8::/32"},
+			want:   []string{"10.0.0.0/8", "93.123.21.124/32", "2001:db8::/32"},
+		},
+		"HostBits": {
+			reason: "Host bits of CIDRs stored before they were rejected on admission are masked.",
+			cidrs:  v1alpha1.CIDRs{"10.1.2.3/8", "2001:db8::1/64"},
+			want:   []string{"10.0.0.0/8", "2001:db8::/64"},
+		},
+		"Invalid": {
+			reason:  "Invalid CIDRs are rejected.",
+			cidrs:   v1alpha1.CIDRs{"10.0.0.0"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := toIPNets(tc.cidrs)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\ntoIPNets(...): want error %t, got %v\n", tc.reason, tc.wantErr, err)
+			}
+			var gotCIDRs []string
+			for _, ipNet := range got {
+				gotCIDRs = append(gotCIDRs, ipNet.String())
+			}
+			if diff := cmp.Diff(tc.want, gotCIDRs); diff != "" {
+				t.Errorf("\n%s\ntoIPNets(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDiffAppliedTo(t *testing.T) {
+	server := func(id int) hcloud.FirewallResource {
+		return hcloud.FirewallResource{Type: hcloud.FirewallResourceTypeServer, Server: &hcloud.FirewallResourceServer{ID: id}}
+	}
+	selector := func(sel string) hcloud.FirewallResource {
+		return hcloud.FirewallResource{Type: hcloud.FirewallResourceTypeLabelSelector, LabelSelector: &hcloud.FirewallResourceLabelSelector{Selector: sel}}
+	}
+
+	cases := map[string]struct {
+		reason   string
+		desired  []hcloud.FirewallResource
+		actual   []hcloud.FirewallResource
+		managed  []v1alpha1.FirewallAppliedTo
+		attached map[string]bool
+		want     appliedToDiff
+	}{
+		"UpToDate": {
+			reason:  "Nothing changes when the Firewall is applied to the desired resources.",
+			desired: []hcloud.FirewallResource{server(1), selector("env=prod")},
+			actual:  []hcloud.FirewallResource{selector("env=prod"), server(1)},
+			managed: []v1alpha1.FirewallAppliedTo{toAppliedTo(server(1)), toAppliedTo(selector("env=prod"))},
+		},
+		"Added": {
+			reason:  "Desired resources the Firewall is not applied to are applied.",
+			desired: []hcloud.FirewallResource{server(1), selector("env=prod")},
+			actual:  []hcloud.FirewallResource{server(1)},
+			managed: []v1alpha1.FirewallAppliedTo{toAppliedTo(server(1))},
+			want:    appliedToDiff{apply: []hcloud.FirewallResource{selector("env=prod")}},
+		},
+		"Removed": {
+			reason:  "Resources removed from the spec are removed from the Firewall.",
+			desired: []hcloud.FirewallResource{server(1)},
+			actual:  []hcloud.FirewallResource{server(1), selector("env=prod")},
+			managed: []v1alpha1.FirewallAppliedTo{toAppliedTo(server(1)), toAppliedTo(selector("env=prod"))},
+			want:    appliedToDiff{remove: []hcloud.FirewallResource{selector("env=prod")}},
+		},
+		"Foreign": {
+			reason:  "Resources the Firewall was applied to otherwise are left alone.",
+			desired: []hcloud.FirewallResource{server(1)},
+			actual:  []hcloud.FirewallResource{server(1), server(2)},
+			managed: []v1alpha1.FirewallAppliedTo{toAppliedTo(server(1))},
+		},
+		"Attached": {
+			reason:   "Resources a FirewallAttachment attaches the Firewall to stay applied.",
+			desired:  []hcloud.FirewallResource{server(1)},
+			actual:   []hcloud.FirewallResource{server(1), server(2)},
+			managed:  []v1alpha1.FirewallAppliedTo{toAppliedTo(server(1)), toAppliedTo(server(2))},
+			attached: map[string]bool{resourceKey(server(2)): true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := diffAppliedTo(tc.desired, tc.actual, tc.managed, tc.attached)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(appliedToDiff{})); diff != "" {
+				t.Errorf("\n%s\ndiffAppliedTo(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAttachedResources(t *testing.T) {
+	deleted := metav1.Now()
+
+	attachments := []v1alpha1.FirewallAttachment{
+		{
+			Spec: v1alpha1.FirewallAttachmentSpec{ForProvider: v1alpha1.FirewallAttachmentParameters{
+				Firewall: hcloud.Ptr("1"),
+				Server:   hcloud.Ptr("2"),
+			}},
+		},
+		{
+			Spec: v1alpha1.FirewallAttachmentSpec{ForProvider: v1alpha1.FirewallAttachmentParameters{
+				Firewall:      hcloud.Ptr("1"),
+				LabelSelector: hcloud.Ptr("env=prod"),
+			}},
+			Status: v1alpha1.FirewallAttachmentStatus{AtProvider: v1alpha1.FirewallAttachmentObservation{
+				Firewall: 1,
+				Attached: &v1alpha1.FirewallAppliedTo{Type: "server", Server: hcloud.Ptr(3)},
+			}},
+		},
+		{
+			Spec: v1alpha1.FirewallAttachmentSpec{ForProvider: v1alpha1.FirewallAttachmentParameters{
+				Firewall: hcloud.Ptr("4"),
+				Server:   hcloud.Ptr("5"),
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &deleted},
+			Spec: v1alpha1.FirewallAttachmentSpec{ForProvider: v1alpha1.FirewallAttachmentParameters{
+				Firewall: hcloud.Ptr("1"),
+				Server:   hcloud.Ptr("6"),
+			}},
+		},
+	}
+
+	want := map[string]bool{"server:2": true, "label_selector:env=prod": true, "server:3": true}
+	if diff := cmp.Diff(want, attachedResources(attachments, 1)); diff != "" {
+		t.Errorf("\nOnly the resources attachments of the Firewall that are not being deleted attach it to should be returned.\nattachedResources(...): -want, +got:\n%s\n", diff)
+	}
+}
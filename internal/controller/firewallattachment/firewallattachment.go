@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewallattachment
+
+import (
+	"context"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/yaskoo/provider-hetzner/apis/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/features"
+)
+
+const (
+	errNotFirewallAttachment = "managed resource is not a FirewallAttachment custom resource"
+	errTrackPCUsage          = "cannot track ProviderConfig usage"
+	errGetPC                 = "cannot get ProviderConfig"
+	errGetCreds              = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errFirewallID = "cannot determine Firewall ID"
+	errResource   = "cannot determine resource to attach the Firewall to"
+	errAttach     = "cannot attach Firewall"
+	errDetach     = "cannot detach Firewall"
+
+	errFirewallGone = "Firewall no longer exists"
+)
+
+// A HCloudService is the interface to the Hetzner cloud API.
+type HCloudService struct {
+	client *hcloud.Client
+}
+
+var (
+	hCloudService = func(creds []byte) (*HCloudService, error) {
+		return &HCloudService{
+			client: hcloud.NewClient(hcloud.WithToken(string(creds))),
+		}, nil
+	}
+)
+
+// Setup adds a controller that reconciles FirewallAttachment managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.FirewallAttachmentGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.FirewallAttachmentGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: hCloudService}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.FirewallAttachment{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (*HCloudService, error)
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.FirewallAttachment)
+	if !ok {
+		return nil, errors.New(errNotFirewallAttachment)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service *HCloudService
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FirewallAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFirewallAttachment)
+	}
+
+	wall, desired, err := c.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if wall == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Once deleted, the attachment is gone as soon as the Firewall is not
+	// applied to what it recorded anymore.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists: ownAttachment(wall, cr.Status.AtProvider.Attached) != nil,
+		}, nil
+	}
+
+	// The attachment exists as long as its Firewall does. It is applied by
+	// Update, which records what it applied in the status, so that only that
+	// is removed again. A Firewall that was already applied to the desired
+	// resource is left as it is and never adopted.
+	attached := isApplied(wall, desired)
+	stale := staleAttachment(wall, desired, cr.Status.AtProvider.Attached)
+	if attached {
+		cr.Status.SetConditions(xpv1.Available())
+	}
+	cr.Status.AtProvider.Firewall = wall.ID
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  attached && stale == nil,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if _, ok := mg.(*v1alpha1.FirewallAttachment); !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFirewallAttachment)
+	}
+
+	// Create is only called while the Firewall does not exist. The Firewall
+	// is applied by Update instead, since only the status it records there
+	// is persisted.
+	return managed.ExternalCreation{}, errors.New(errFirewallGone)
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FirewallAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFirewallAttachment)
+	}
+
+	return managed.ExternalUpdate{}, c.attach(ctx, cr)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FirewallAttachment)
+	if !ok {
+		return errors.New(errNotFirewallAttachment)
+	}
+
+	wall, _, err := c.get(ctx, cr)
+	if err != nil || wall == nil {
+		return err
+	}
+
+	res := ownAttachment(wall, cr.Status.AtProvider.Attached)
+	if res == nil {
+		return nil
+	}
+	return errors.Wrap(c.remove(ctx, wall, []hcloud.FirewallResource{*res}), errDetach)
+}
+
+// attach removes the Firewall from the resource it was attached to before, if
+// that changed, and applies it to the desired resource. The status records
+// only what the attachment applied itself.
+func (c *external) attach(ctx context.Context, cr *v1alpha1.FirewallAttachment) error {
+	wall, desired, err := c.get(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if wall == nil {
+		return errors.New(errFirewallGone)
+	}
+
+	if stale := staleAttachment(wall, desired, cr.Status.AtProvider.Attached); stale != nil {
+		if err := c.remove(ctx, wall, []hcloud.FirewallResource{*stale}); err != nil {
+			return errors.Wrap(err, errDetach)
+		}
+	}
+	if a := cr.Status.AtProvider.Attached; a != nil && resourceKey(fromAppliedTo(*a)) != resourceKey(desired) {
+		cr.Status.AtProvider.Attached = nil
+	}
+
+	if isApplied(wall, desired) {
+		return nil
+	}
+	actions, _, err := c.service.client.Firewall.ApplyResources(ctx, wall, []hcloud.FirewallResource{desired})
+	if err == nil {
+		err = util.WaitForActions(ctx, c.service.client, actions)
+	}
+	if err != nil {
+		return errors.Wrap(err, errAttach)
+	}
+	cr.Status.AtProvider.Attached = toAppliedTo(desired)
+	return nil
+}
+
+func (c *external) remove(ctx context.Context, wall *hcloud.Firewall, resources []hcloud.FirewallResource) error {
+	actions, _, err := c.service.client.Firewall.RemoveResources(ctx, wall, resources)
+	if err != nil {
+		return err
+	}
+	return util.WaitForActions(ctx, c.service.client, actions)
+}
+
+// get returns the Firewall to attach, nil if it does not exist, and the
+// resource to attach it to.
+func (c *external) get(ctx context.Context, cr *v1alpha1.FirewallAttachment) (*hcloud.Firewall, hcloud.FirewallResource, error) {
+	id, err := toID(cr.Spec.ForProvider.Firewall)
+	if err != nil {
+		return nil, hcloud.FirewallResource{}, errors.Wrap(err, errFirewallID)
+	}
+
+	desired, err := toFirewallResource(cr.Spec.ForProvider)
+	if err != nil {
+		return nil, hcloud.FirewallResource{}, errors.Wrap(err, errResource)
+	}
+
+	wall, _, err := c.service.client.Firewall.GetByID(ctx, id)
+	return wall, desired, err
+}
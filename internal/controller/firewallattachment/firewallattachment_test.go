@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewallattachment
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+// firewallService returns a service whose API serves the Firewall with ID 1,
+// applied to the Server with ID 2.
+func firewallService(t *testing.T) *HCloudService {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/firewalls/1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"firewall": {"id": 1, "name": "web", "created": "2022-01-01T00:00:00+00:00",
+			"applied_to": [{"type": "server", "server": {"id": 2}}]}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return &HCloudService{client: hcloud.NewClient(hcloud.WithEndpoint(srv.URL), hcloud.WithToken("token"))}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	attachment := func(server string, attached *int, deleted bool) *v1alpha1.FirewallAttachment {
+		cr := &v1alpha1.FirewallAttachment{
+			Spec: v1alpha1.FirewallAttachmentSpec{ForProvider: v1alpha1.FirewallAttachmentParameters{
+				Firewall: hcloud.Ptr("1"),
+				Server:   &server,
+			}},
+		}
+		if attached != nil {
+			cr.Status.AtProvider.Attached = &v1alpha1.FirewallAppliedTo{Type: "server", Server: attached}
+		}
+		if deleted {
+			now := metav1.Now()
+			cr.SetDeletionTimestamp(&now)
+		}
+		return cr
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Attached": {
+			reason: "An attachment whose Firewall is applied to what it recorded should be up to date.",
+			args:   args{ctx: context.Background(), mg: attachment("2", hcloud.Ptr(2), false)},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"DeletedAttached": {
+			reason: "A deleted attachment should exist while the Firewall is applied to what it recorded.",
+			args:   args{ctx: context.Background(), mg: attachment("2", hcloud.Ptr(2), true)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"DeletedRemoved": {
+			reason: "A deleted attachment should be gone once the Firewall is not applied to what it recorded.",
+			args:   args{ctx: context.Background(), mg: attachment("3", hcloud.Ptr(3), true)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"DeletedUnrecorded": {
+			reason: "A deleted attachment that never applied the Firewall should be gone, even if the Firewall is applied to its resource.",
+			args:   args{ctx: context.Background(), mg: attachment("2", nil, true)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{service: firewallService(t)}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
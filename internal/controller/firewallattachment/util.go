@@ -0,0 +1,109 @@
+package firewallattachment
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+// toID parses an ID that is either set directly or resolved from a reference.
+func toID(id *string) (int, error) {
+	if id == nil {
+		return 0, errors.New("neither set nor resolved from a reference")
+	}
+	return strconv.Atoi(*id)
+}
+
+// toFirewallResource returns the resource the Firewall is attached to.
+func toFirewallResource(p v1alpha1.FirewallAttachmentParameters) (hcloud.FirewallResource, error) {
+	switch {
+	case p.LabelSelector != nil && (p.Server != nil || p.ServerRef != nil || p.ServerSelector != nil):
+		return hcloud.FirewallResource{}, errors.New("only one of server and labelSelector may be set")
+	case p.LabelSelector != nil:
+		return hcloud.FirewallResource{
+			Type:          hcloud.FirewallResourceTypeLabelSelector,
+			LabelSelector: &hcloud.FirewallResourceLabelSelector{Selector: *p.LabelSelector},
+		}, nil
+	}
+
+	id, err := toID(p.Server)
+	if err != nil {
+		return hcloud.FirewallResource{}, errors.Wrap(err, "server")
+	}
+	return hcloud.FirewallResource{
+		Type:   hcloud.FirewallResourceTypeServer,
+		Server: &hcloud.FirewallResourceServer{ID: id},
+	}, nil
+}
+
+func toAppliedTo(res hcloud.FirewallResource) *v1alpha1.FirewallAppliedTo {
+	applied := &v1alpha1.FirewallAppliedTo{Type: string(res.Type)}
+	if res.Server != nil {
+		id := res.Server.ID
+		applied.Server = &id
+	}
+	if res.LabelSelector != nil {
+		applied.LabelSelector = res.LabelSelector.Selector
+	}
+	return applied
+}
+
+func fromAppliedTo(applied v1alpha1.FirewallAppliedTo) hcloud.FirewallResource {
+	res := hcloud.FirewallResource{Type: hcloud.FirewallResourceType(applied.Type)}
+	if applied.Server != nil {
+		res.Server = &hcloud.FirewallResourceServer{ID: *applied.Server}
+	}
+	if applied.LabelSelector != "" {
+		res.LabelSelector = &hcloud.FirewallResourceLabelSelector{Selector: applied.LabelSelector}
+	}
+	return res
+}
+
+// resourceKey identifies a resource a Firewall is applied to.
+func resourceKey(res hcloud.FirewallResource) string {
+	switch {
+	case res.Server != nil:
+		return fmt.Sprintf("%s:%d", res.Type, res.Server.ID)
+	case res.LabelSelector != nil:
+		return fmt.Sprintf("%s:%s", res.Type, res.LabelSelector.Selector)
+	}
+	return string(res.Type)
+}
+
+// isApplied reports whether the Firewall is applied to the resource.
+func isApplied(wall *hcloud.Firewall, res hcloud.FirewallResource) bool {
+	key := resourceKey(res)
+	for _, applied := range wall.AppliedTo {
+		if resourceKey(applied) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ownAttachment returns the resource the attachment applied the Firewall to,
+// if it is still applied to it.
+func ownAttachment(wall *hcloud.Firewall, attached *v1alpha1.FirewallAppliedTo) *hcloud.FirewallResource {
+	if attached == nil {
+		return nil
+	}
+	res := fromAppliedTo(*attached)
+	if !isApplied(wall, res) {
+		return nil
+	}
+	return &res
+}
+
+// staleAttachment returns the resource the Firewall was attached to before
+// the attachment changed, if it is still applied to it.
+func staleAttachment(wall *hcloud.Firewall, desired hcloud.FirewallResource, attached *v1alpha1.FirewallAppliedTo) *hcloud.FirewallResource {
+	res := ownAttachment(wall, attached)
+	if res == nil || resourceKey(*res) == resourceKey(desired) {
+		return nil
+	}
+	return res
+}
@@ -0,0 +1,134 @@
+package firewallattachment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestStaleAttachment(t *testing.T) {
+	server := func(id int) hcloud.FirewallResource {
+		return hcloud.FirewallResource{Type: hcloud.FirewallResourceTypeServer, Server: &hcloud.FirewallResourceServer{ID: id}}
+	}
+	selector := func(sel string) hcloud.FirewallResource {
+		return hcloud.FirewallResource{Type: hcloud.FirewallResourceTypeLabelSelector, LabelSelector: &hcloud.FirewallResourceLabelSelector{Selector: sel}}
+	}
+	wall := &hcloud.Firewall{AppliedTo: []hcloud.FirewallResource{server(1), selector("role=web")}}
+
+	cases := map[string]struct {
+		reason   string
+		desired  hcloud.FirewallResource
+		attached *v1alpha1.FirewallAppliedTo
+		want     *hcloud.FirewallResource
+	}{
+		"Unattached": {
+			reason:  "Nothing is stale before the Firewall was attached.",
+			desired: server(1),
+		},
+		"Unchanged": {
+			reason:   "Nothing is stale while the attachment is unchanged.",
+			desired:  server(1),
+			attached: toAppliedTo(server(1)),
+		},
+		"Changed": {
+			reason:   "The previously attached resource is stale once the attachment changed.",
+			desired:  server(2),
+			attached: toAppliedTo(selector("role=web")),
+			want:     func() *hcloud.FirewallResource { r := selector("role=web"); return &r }(),
+		},
+		"Removed": {
+			reason:   "A previously attached resource the Firewall is not applied to anymore is not stale.",
+			desired:  server(1),
+			attached: toAppliedTo(server(3)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := staleAttachment(wall, tc.desired, tc.attached)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nstaleAttachment(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestOwnAttachment(t *testing.T) {
+	res := hcloud.FirewallResource{Type: hcloud.FirewallResourceTypeServer, Server: &hcloud.FirewallResourceServer{ID: 1}}
+
+	cases := map[string]struct {
+		reason   string
+		wall     *hcloud.Firewall
+		attached *v1alpha1.FirewallAppliedTo
+		want     *hcloud.FirewallResource
+	}{
+		"Unrecorded": {
+			reason: "A Firewall the attachment did not apply should never be removed.",
+			wall:   &hcloud.Firewall{AppliedTo: []hcloud.FirewallResource{res}},
+		},
+		"Applied": {
+			reason:   "The recorded resource should be removed while the Firewall is applied to it.",
+			wall:     &hcloud.Firewall{AppliedTo: []hcloud.FirewallResource{res}},
+			attached: toAppliedTo(res),
+			want:     &res,
+		},
+		"Removed": {
+			reason:   "A recorded resource the Firewall is not applied to anymore should not be removed.",
+			wall:     &hcloud.Firewall{},
+			attached: toAppliedTo(res),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ownAttachment(tc.wall, tc.attached)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nownAttachment(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestToFirewallResource(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		params  v1alpha1.FirewallAttachmentParameters
+		want    hcloud.FirewallResource
+		wantErr bool
+	}{
+		"Server": {
+			reason: "A Server is attached by its ID.",
+			params: v1alpha1.FirewallAttachmentParameters{Server: hcloud.Ptr("42")},
+			want:   hcloud.FirewallResource{Type: hcloud.FirewallResourceTypeServer, Server: &hcloud.FirewallResourceServer{ID: 42}},
+		},
+		"LabelSelector": {
+			reason: "A label selector is attached as is.",
+			params: v1alpha1.FirewallAttachmentParameters{LabelSelector: hcloud.Ptr("role=web")},
+			want:   hcloud.FirewallResource{Type: hcloud.FirewallResourceTypeLabelSelector, LabelSelector: &hcloud.FirewallResourceLabelSelector{Selector: "role=web"}},
+		},
+		"Both": {
+			reason:  "Only one of a Server and a label selector can be attached.",
+			params:  v1alpha1.FirewallAttachmentParameters{Server: hcloud.Ptr("42"), LabelSelector: hcloud.Ptr("role=web")},
+			wantErr: true,
+		},
+		"Neither": {
+			reason:  "Either a Server or a label selector has to be attached.",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := toFirewallResource(tc.params)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\ntoFirewallResource(...): want error %t, got %v\n", tc.reason, tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntoFirewallResource(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package floatingip
+
+import (
+	"context"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/yaskoo/provider-hetzner/apis/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/features"
+)
+
+const (
+	errNotFloatingIP = "managed resource is not a FloatingIP custom resource"
+	errTrackPCUsage  = "cannot track ProviderConfig usage"
+	errGetPC         = "cannot get ProviderConfig"
+	errGetCreds      = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errChangeDNSPtr     = "cannot change FloatingIP reverse DNS entry"
+	errChangeProtection = "cannot change FloatingIP protection"
+)
+
+// A HCloudService is the interface to the Hetzner cloud API.
+type HCloudService struct {
+	client *hcloud.Client
+}
+
+var (
+	hCloudService = func(creds []byte) (*HCloudService, error) {
+		return &HCloudService{
+			client: hcloud.NewClient(hcloud.WithToken(string(creds))),
+		}, nil
+	}
+)
+
+// Setup adds a controller that reconciles FloatingIP managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.FloatingIPGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.FloatingIPGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: hCloudService}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.FloatingIP{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (*HCloudService, error)
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.FloatingIP)
+	if !ok {
+		return nil, errors.New(errNotFloatingIP)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service *HCloudService
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FloatingIP)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFloatingIP)
+	}
+
+	fip, _, err := c.service.client.FloatingIP.GetByName(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	exists := fip != nil && fip.ID > 0
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.Id = fip.ID
+	cr.Status.AtProvider.Created = &metav1.Time{Time: fip.Created}
+	cr.Status.AtProvider.IP = fip.IP.String()
+	cr.Status.AtProvider.Server = nil
+	if fip.Server != nil {
+		id := fip.Server.ID
+		cr.Status.AtProvider.Server = &id
+	}
+
+	fp := cr.Spec.ForProvider
+	rdns, err := util.DNSPtrChanges(fp.RDNS, fip, util.HostNet(fip.IP), fip.Network)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errChangeDNSPtr)
+	}
+	upToDate := util.LabelsUpToDate(fp.Labels, fip.Labels) &&
+		(fp.Description == nil || *fp.Description == fip.Description) &&
+		(fp.DNSPtr == nil || *fp.DNSPtr == fip.DNSPtrForIP(dnsPtrIP(fip))) &&
+		len(rdns) == 0 &&
+		util.ProtectionUpToDate(fp.Protection.GetDelete(), fip.Protection.Delete)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		ConnectionDetails: managed.ConnectionDetails{
+			"ip": []byte(fip.IP.String()),
+		},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FloatingIP)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFloatingIP)
+	}
+
+	// The reverse DNS entry is set on the following Update.
+	name := meta.GetExternalName(cr)
+	_, _, err := c.service.client.FloatingIP.Create(ctx, hcloud.FloatingIPCreateOpts{
+		Name: &name,
+		Type: hcloud.FloatingIPType(cr.Spec.ForProvider.Type),
+		HomeLocation: &hcloud.Location{
+			ID:   int(cr.Spec.ForProvider.HomeLocation.IntVal),
+			Name: cr.Spec.ForProvider.HomeLocation.StrVal,
+		},
+		Description: cr.Spec.ForProvider.Description,
+		Labels:      cr.Spec.ForProvider.Labels,
+	})
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, err
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FloatingIP)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFloatingIP)
+	}
+
+	labels := cr.Spec.ForProvider.Labels
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	opts := hcloud.FloatingIPUpdateOpts{
+		Labels: labels,
+	}
+	if cr.Spec.ForProvider.Description != nil {
+		opts.Description = *cr.Spec.ForProvider.Description
+	}
+
+	fip, _, err := c.service.client.FloatingIP.Update(ctx, &hcloud.FloatingIP{ID: cr.Status.AtProvider.Id}, opts)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if protect := cr.Spec.ForProvider.Protection.GetDelete(); protect != nil && *protect != fip.Protection.Delete {
+		action, _, err := c.service.client.FloatingIP.ChangeProtection(ctx, fip, hcloud.FloatingIPChangeProtectionOpts{
+			Delete: protect,
+		})
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeProtection)
+		}
+	}
+
+	ip := dnsPtrIP(fip)
+	if cr.Spec.ForProvider.DNSPtr != nil && *cr.Spec.ForProvider.DNSPtr != fip.DNSPtrForIP(ip) {
+		action, _, err := c.service.client.FloatingIP.ChangeDNSPtr(ctx, fip, ip.String(), cr.Spec.ForProvider.DNSPtr)
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeDNSPtr)
+		}
+	}
+
+	rdns, err := util.DNSPtrChanges(cr.Spec.ForProvider.RDNS, fip, util.HostNet(fip.IP), fip.Network)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errChangeDNSPtr)
+	}
+	for _, change := range rdns {
+		action, _, err := c.service.client.FloatingIP.ChangeDNSPtr(ctx, fip, change.IP.String(), hcloud.Ptr(change.Hostname))
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeDNSPtr)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FloatingIP)
+	if !ok {
+		return errors.New(errNotFloatingIP)
+	}
+
+	fip := &hcloud.FloatingIP{ID: cr.Status.AtProvider.Id}
+	return util.DeleteUnlessProtected(cr, cr.Spec.ForProvider.Protection.GetDelete(), func() error {
+		_, err := c.service.client.FloatingIP.Delete(ctx, fip)
+		return err
+	}, func() error {
+		action, _, err := c.service.client.FloatingIP.ChangeProtection(ctx, fip, hcloud.FloatingIPChangeProtectionOpts{
+			Delete: hcloud.Ptr(false),
+		})
+		if err != nil {
+			return err
+		}
+		return util.WaitForAction(ctx, c.service.client, action)
+	})
+}
@@ -0,0 +1,20 @@
+package floatingip
+
+import (
+	"net"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+)
+
+// dnsPtrIP returns the address the reverse DNS entry of a FloatingIP is set
+// for. IPv6 FloatingIPs are whole networks, so the ::1 address is used.
+func dnsPtrIP(fip *hcloud.FloatingIP) net.IP {
+	if fip.Type != hcloud.FloatingIPTypeIPv6 {
+		return fip.IP
+	}
+
+	ip := make(net.IP, len(fip.IP.To16()))
+	copy(ip, fip.IP.To16())
+	ip[len(ip)-1] |= 1
+	return ip
+}
@@ -0,0 +1,32 @@
+package floatingip
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+)
+
+func TestDNSPtrIP(t *testing.T) {
+	cases := map[string]struct {
+		fip  *hcloud.FloatingIP
+		want string
+	}{
+		"IPv4": {
+			fip:  &hcloud.FloatingIP{Type: hcloud.FloatingIPTypeIPv4, IP: net.ParseIP("203.0.113.1")},
+			want: "203.0.113.1",
+		},
+		"IPv6": {
+			fip:  &hcloud.FloatingIP{Type: hcloud.FloatingIPTypeIPv6, IP: net.ParseIP("2001:db8:1:2::")},
+			want: "2001:db8:1:2::1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := dnsPtrIP(tc.fip).String(); got != tc.want {
+				t.Errorf("dnsPtrIP(...): want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package floatingipassignment
+
+import (
+	"context"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/yaskoo/provider-hetzner/apis/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/features"
+)
+
+const (
+	errNotFloatingIPAssignment = "managed resource is not a FloatingIPAssignment custom resource"
+	errTrackPCUsage            = "cannot track ProviderConfig usage"
+	errGetPC                   = "cannot get ProviderConfig"
+	errGetCreds                = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errFloatingIPID = "cannot determine FloatingIP ID"
+	errServerID     = "cannot determine Server ID"
+	errAssign       = "cannot assign FloatingIP"
+	errUnassign     = "cannot unassign FloatingIP"
+)
+
+// A HCloudService is the interface to the Hetzner cloud API.
+type HCloudService struct {
+	client *hcloud.Client
+}
+
+var (
+	hCloudService = func(creds []byte) (*HCloudService, error) {
+		return &HCloudService{
+			client: hcloud.NewClient(hcloud.WithToken(string(creds))),
+		}, nil
+	}
+)
+
+// Setup adds a controller that reconciles FloatingIPAssignment managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.FloatingIPAssignmentGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.FloatingIPAssignmentGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: hCloudService}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.FloatingIPAssignment{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (*HCloudService, error)
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.FloatingIPAssignment)
+	if !ok {
+		return nil, errors.New(errNotFloatingIPAssignment)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service *HCloudService
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FloatingIPAssignment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFloatingIPAssignment)
+	}
+
+	fipID, err := toID(cr.Spec.ForProvider.FloatingIP)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFloatingIPID)
+	}
+
+	serverID, err := toID(cr.Spec.ForProvider.Server)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errServerID)
+	}
+
+	fip, _, err := c.service.client.FloatingIP.GetByID(ctx, fipID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// The assignment only exists while the FloatingIP is assigned to the
+	// desired Server. Assigning it to another Server moves it.
+	if !assignedTo(fip, serverID) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.IP = fip.IP.String()
+	cr.Status.AtProvider.Server = fip.Server.ID
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+		ConnectionDetails: managed.ConnectionDetails{
+			"ip": []byte(fip.IP.String()),
+		},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FloatingIPAssignment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFloatingIPAssignment)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, c.assign(ctx, cr)
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FloatingIPAssignment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFloatingIPAssignment)
+	}
+
+	return managed.ExternalUpdate{}, c.assign(ctx, cr)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FloatingIPAssignment)
+	if !ok {
+		return errors.New(errNotFloatingIPAssignment)
+	}
+
+	fipID, err := toID(cr.Spec.ForProvider.FloatingIP)
+	if err != nil {
+		return errors.Wrap(err, errFloatingIPID)
+	}
+
+	serverID, err := toID(cr.Spec.ForProvider.Server)
+	if err != nil {
+		return errors.Wrap(err, errServerID)
+	}
+
+	// The FloatingIP is left alone once it was moved to another Server.
+	fip, _, err := c.service.client.FloatingIP.GetByID(ctx, fipID)
+	if err != nil {
+		return err
+	}
+	if !assignedTo(fip, serverID) {
+		return nil
+	}
+
+	action, _, err := c.service.client.FloatingIP.Unassign(ctx, fip)
+	if err == nil {
+		err = util.WaitForAction(ctx, c.service.client, action)
+	}
+	return errors.Wrap(err, errUnassign)
+}
+
+// assign assigns the FloatingIP to the Server, moving it away from the Server
+// it is currently assigned to, if any.
+func (c *external) assign(ctx context.Context, cr *v1alpha1.FloatingIPAssignment) error {
+	fipID, err := toID(cr.Spec.ForProvider.FloatingIP)
+	if err != nil {
+		return errors.Wrap(err, errFloatingIPID)
+	}
+
+	serverID, err := toID(cr.Spec.ForProvider.Server)
+	if err != nil {
+		return errors.Wrap(err, errServerID)
+	}
+
+	action, _, err := c.service.client.FloatingIP.Assign(ctx, &hcloud.FloatingIP{ID: fipID}, &hcloud.Server{ID: serverID})
+	if err == nil {
+		err = util.WaitForAction(ctx, c.service.client, action)
+	}
+	return errors.Wrap(err, errAssign)
+}
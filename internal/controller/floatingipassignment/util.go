@@ -0,0 +1,21 @@
+package floatingipassignment
+
+import (
+	"strconv"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+)
+
+// toID parses an ID that is either set directly or resolved from a reference.
+func toID(id *string) (int, error) {
+	if id == nil {
+		return 0, errors.New("neither set nor resolved from a reference")
+	}
+	return strconv.Atoi(*id)
+}
+
+// assignedTo reports whether the FloatingIP is assigned to the Server.
+func assignedTo(fip *hcloud.FloatingIP, serverID int) bool {
+	return fip != nil && fip.Server != nil && fip.Server.ID == serverID
+}
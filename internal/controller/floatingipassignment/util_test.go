@@ -0,0 +1,79 @@
+package floatingipassignment
+
+import (
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+)
+
+func TestAssignedTo(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		fip    *hcloud.FloatingIP
+		want   bool
+	}{
+		"Gone": {
+			reason: "A FloatingIP that does not exist is not assigned.",
+		},
+		"Unassigned": {
+			reason: "An unassigned FloatingIP is not assigned to the Server.",
+			fip:    &hcloud.FloatingIP{ID: 1},
+		},
+		"AssignedToServer": {
+			reason: "A FloatingIP assigned to the Server is assigned.",
+			fip:    &hcloud.FloatingIP{ID: 1, Server: &hcloud.Server{ID: 42}},
+			want:   true,
+		},
+		"AssignedToOtherServer": {
+			reason: "A FloatingIP assigned to another Server is not assigned to the Server.",
+			fip:    &hcloud.FloatingIP{ID: 1, Server: &hcloud.Server{ID: 7}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := assignedTo(tc.fip, 42); got != tc.want {
+				t.Errorf("\n%s\nassignedTo(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestToID(t *testing.T) {
+	id := "42"
+	invalid := "my-server"
+
+	cases := map[string]struct {
+		reason  string
+		id      *string
+		want    int
+		wantErr bool
+	}{
+		"Resolved": {
+			reason: "A set or resolved ID is parsed.",
+			id:     &id,
+			want:   42,
+		},
+		"Unresolved": {
+			reason:  "An ID that is neither set nor resolved is an error.",
+			wantErr: true,
+		},
+		"Invalid": {
+			reason:  "An ID that is not numeric is an error.",
+			id:      &invalid,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := toID(tc.id)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\ntoID(...): want error %t, got %v\n", tc.reason, tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("\n%s\ntoID(...): want %d, got %d\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
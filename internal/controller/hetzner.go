@@ -19,12 +19,23 @@ package controller
 import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/yaskoo/provider-hetzner/internal/controller/placementgroup"
+	"github.com/yaskoo/provider-hetzner/internal/controller/primaryip"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/yaskoo/provider-hetzner/internal/controller/certificate"
 	"github.com/yaskoo/provider-hetzner/internal/controller/config"
 	"github.com/yaskoo/provider-hetzner/internal/controller/firewall"
+	"github.com/yaskoo/provider-hetzner/internal/controller/firewallattachment"
+	"github.com/yaskoo/provider-hetzner/internal/controller/floatingip"
+	"github.com/yaskoo/provider-hetzner/internal/controller/floatingipassignment"
+	"github.com/yaskoo/provider-hetzner/internal/controller/image"
+	"github.com/yaskoo/provider-hetzner/internal/controller/loadbalancer"
+	"github.com/yaskoo/provider-hetzner/internal/controller/network"
+	"github.com/yaskoo/provider-hetzner/internal/controller/route"
 	"github.com/yaskoo/provider-hetzner/internal/controller/server"
 	"github.com/yaskoo/provider-hetzner/internal/controller/sshkey"
+	"github.com/yaskoo/provider-hetzner/internal/controller/subnet"
+	"github.com/yaskoo/provider-hetzner/internal/controller/volume"
 )
 
 // Setup creates all Hetzner controllers with the supplied logger and adds them to
@@ -35,7 +46,18 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		sshkey.Setup,
 		server.Setup,
 		firewall.Setup,
+		firewallattachment.Setup,
 		placementgroup.Setup,
+		volume.Setup,
+		network.Setup,
+		subnet.Setup,
+		route.Setup,
+		loadbalancer.Setup,
+		floatingip.Setup,
+		floatingipassignment.Setup,
+		primaryip.Setup,
+		certificate.Setup,
+		image.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
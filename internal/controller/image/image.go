@@ -0,0 +1,305 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/yaskoo/provider-hetzner/apis/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/features"
+)
+
+const (
+	errNotImage     = "managed resource is not a Image custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errServer      = "cannot parse Image server"
+	errCreateImage = "cannot create Image"
+	errGetAction   = "cannot get create Image action"
+
+	errChangeProtection = "cannot change Image protection"
+)
+
+// annotationCreateAction records the action capturing the Image, so that its
+// failure can be told apart from an Image that was deleted. Its presence marks
+// the external name as the ID of the created Image.
+const annotationCreateAction = "cloud.hetzner.crossplane.io/create-image-action"
+
+// A HCloudService is the interface to the Hetzner cloud API.
+type HCloudService struct {
+	client *hcloud.Client
+}
+
+var (
+	hCloudService = func(creds []byte) (*HCloudService, error) {
+		return &HCloudService{
+			client: hcloud.NewClient(hcloud.WithToken(string(creds))),
+		}, nil
+	}
+)
+
+// Setup adds a controller that reconciles Image managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ImageGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ImageGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: hCloudService}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Image{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (*HCloudService, error)
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Image)
+	if !ok {
+		return nil, errors.New(errNotImage)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service *HCloudService
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Image)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotImage)
+	}
+
+	// Images have no unique name, Create sets the external name to the ID.
+	// Numeric names of Images that were never created are not IDs, which
+	// would adopt unrelated Images.
+	id, ok := imageID(cr)
+	if !ok {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	img, _, err := c.service.client.Image.GetByID(ctx, id)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	exists := img != nil && img.ID > 0
+	if !exists {
+		if err := c.createActionError(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = toImageObservation(img)
+	if img.Status == hcloud.ImageStatusAvailable {
+		cr.Status.SetConditions(xpv1.Available())
+	} else {
+		cr.Status.SetConditions(xpv1.Creating())
+	}
+
+	fp := cr.Spec.ForProvider
+	upToDate := util.LabelsUpToDate(fp.Labels, img.Labels) &&
+		(fp.Description == nil || *fp.Description == img.Description) &&
+		(fp.Type == "" || fp.Type == string(img.Type)) &&
+		util.ProtectionUpToDate(fp.Protection.GetDelete(), img.Protection.Delete)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Image)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotImage)
+	}
+
+	server, err := toID(cr.Spec.ForProvider.Server)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errServer)
+	}
+
+	res, _, err := c.service.client.Server.CreateImage(ctx, &hcloud.Server{ID: server}, &hcloud.ServerCreateImageOpts{
+		Type:        hcloud.ImageType(cr.Spec.ForProvider.Type),
+		Description: cr.Spec.ForProvider.Description,
+		Labels:      cr.Spec.ForProvider.Labels,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateImage)
+	}
+
+	// Capturing the Image takes a while, Observe keeps track of it.
+	meta.SetExternalName(cr, strconv.Itoa(res.Image.ID))
+	action := ""
+	if res.Action != nil {
+		action = strconv.Itoa(res.Action.ID)
+	}
+	meta.AddAnnotations(cr, map[string]string{annotationCreateAction: action})
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Image)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotImage)
+	}
+
+	labels := cr.Spec.ForProvider.Labels
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	img := &hcloud.Image{ID: cr.Status.AtProvider.Id}
+	if _, _, err := c.service.client.Image.Update(ctx, img, hcloud.ImageUpdateOpts{
+		Description: cr.Spec.ForProvider.Description,
+		Type:        convertTo(cr.Spec.ForProvider.Type, cr.Status.AtProvider.Type),
+		Labels:      labels,
+	}); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if protect := cr.Spec.ForProvider.Protection.GetDelete(); protect != nil {
+		action, _, err := c.service.client.Image.ChangeProtection(ctx, img, hcloud.ImageChangeProtectionOpts{
+			Delete: protect,
+		})
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeProtection)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Image)
+	if !ok {
+		return errors.New(errNotImage)
+	}
+
+	img := &hcloud.Image{ID: cr.Status.AtProvider.Id}
+	return util.DeleteUnlessProtected(cr, cr.Spec.ForProvider.Protection.GetDelete(), func() error {
+		_, err := c.service.client.Image.Delete(ctx, img)
+		return err
+	}, func() error {
+		action, _, err := c.service.client.Image.ChangeProtection(ctx, img, hcloud.ImageChangeProtectionOpts{
+			Delete: hcloud.Ptr(false),
+		})
+		if err != nil {
+			return err
+		}
+		return util.WaitForAction(ctx, c.service.client, action)
+	})
+}
+
+// createActionError returns the error the action capturing the Image failed
+// with, if any.
+func (c *external) createActionError(ctx context.Context, cr *v1alpha1.Image) error {
+	id, err := strconv.Atoi(cr.GetAnnotations()[annotationCreateAction])
+	if err != nil {
+		return nil
+	}
+
+	action, _, err := c.service.client.Action.GetByID(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, errGetAction)
+	}
+	if action != nil && action.Status == hcloud.ActionStatusError {
+		return errors.Wrap(action.Error(), errCreateImage)
+	}
+	return nil
+}
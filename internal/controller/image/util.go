@@ -0,0 +1,68 @@
+package image
+
+import (
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+// toID parses an ID that is either set directly or resolved from a reference.
+func toID(id *string) (int, error) {
+	if id == nil {
+		return 0, errors.New("neither set nor resolved from a reference")
+	}
+	return strconv.Atoi(*id)
+}
+
+// imageID returns the ID of the Image, which is only known once Create set it
+// as the external name.
+func imageID(cr *v1alpha1.Image) (int, bool) {
+	_, created := cr.GetAnnotations()[annotationCreateAction]
+	if !created && meta.GetExternalCreateSucceeded(cr).IsZero() {
+		return 0, false
+	}
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	return id, err == nil
+}
+
+// convertTo returns the type an Image is converted to, empty unless a backup
+// is meant to be a snapshot. Backups can only be converted into snapshots, not
+// the other way around.
+func convertTo(desired, actual string) hcloud.ImageType {
+	if hcloud.ImageType(desired) == hcloud.ImageTypeSnapshot && hcloud.ImageType(actual) == hcloud.ImageTypeBackup {
+		return hcloud.ImageTypeSnapshot
+	}
+	return ""
+}
+
+func toImageObservation(img *hcloud.Image) v1alpha1.ImageObservation {
+	obs := v1alpha1.ImageObservation{
+		Id:       img.ID,
+		Created:  &metav1.Time{Time: img.Created},
+		Type:     string(img.Type),
+		Status:   string(img.Status),
+		OSFlavor: img.OSFlavor,
+	}
+
+	// The size of the Image is only known once it has been captured.
+	if img.ImageSize > 0 {
+		obs.ImageSize = formatGB(img.ImageSize)
+	}
+	if img.DiskSize > 0 {
+		obs.DiskSize = formatGB(img.DiskSize)
+	}
+	if img.CreatedFrom != nil {
+		id := img.CreatedFrom.ID
+		obs.CreatedFrom = &id
+	}
+	return obs
+}
+
+func formatGB(size float32) string {
+	return strconv.FormatFloat(float64(size), 'f', -1, 32) + "GB"
+}
@@ -0,0 +1,152 @@
+package image
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestToImageObservation(t *testing.T) {
+	created := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	server := 42
+
+	cases := map[string]struct {
+		reason string
+		img    *hcloud.Image
+		want   v1alpha1.ImageObservation
+	}{
+		"Creating": {
+			reason: "An Image that is still being captured should have no size.",
+			img: &hcloud.Image{
+				ID:          1,
+				Type:        hcloud.ImageTypeSnapshot,
+				Status:      hcloud.ImageStatusCreating,
+				Created:     created,
+				CreatedFrom: &hcloud.Server{ID: server},
+			},
+			want: v1alpha1.ImageObservation{
+				Id:          1,
+				Created:     &metav1.Time{Time: created},
+				Type:        "snapshot",
+				Status:      "creating",
+				CreatedFrom: &server,
+			},
+		},
+		"Available": {
+			reason: "An available Image should report its sizes in GB.",
+			img: &hcloud.Image{
+				ID:        1,
+				Type:      hcloud.ImageTypeSnapshot,
+				Status:    hcloud.ImageStatusAvailable,
+				Created:   created,
+				ImageSize: 1.25,
+				DiskSize:  20,
+				OSFlavor:  "ubuntu",
+			},
+			want: v1alpha1.ImageObservation{
+				Id:        1,
+				Created:   &metav1.Time{Time: created},
+				Type:      "snapshot",
+				Status:    "available",
+				ImageSize: "1.25GB",
+				DiskSize:  "20GB",
+				OSFlavor:  "ubuntu",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := toImageObservation(tc.img)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntoImageObservation(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestImageID(t *testing.T) {
+	cases := map[string]struct {
+		reason      string
+		annotations map[string]string
+		wantID      int
+		wantOK      bool
+	}{
+		"NotCreated": {
+			reason:      "A numeric name of an Image that was never created is not an ID.",
+			annotations: map[string]string{meta.AnnotationKeyExternalName: "123"},
+		},
+		"Created": {
+			reason:      "The external name is the ID once Create set it.",
+			annotations: map[string]string{meta.AnnotationKeyExternalName: "123", annotationCreateAction: "456"},
+			wantID:      123,
+			wantOK:      true,
+		},
+		"CreateSucceeded": {
+			reason:      "The external name is the ID of Images created before the action was recorded.",
+			annotations: map[string]string{meta.AnnotationKeyExternalName: "123", meta.AnnotationKeyExternalCreateSucceeded: time.Now().Format(time.RFC3339)},
+			wantID:      123,
+			wantOK:      true,
+		},
+		"NotNumeric": {
+			reason:      "A name that is not numeric is not an ID.",
+			annotations: map[string]string{meta.AnnotationKeyExternalName: "my-image", annotationCreateAction: ""},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.Image{}
+			cr.SetAnnotations(tc.annotations)
+			id, ok := imageID(cr)
+			if id != tc.wantID || ok != tc.wantOK {
+				t.Errorf("\n%s\nimageID(...): want %d, %t, got %d, %t\n", tc.reason, tc.wantID, tc.wantOK, id, ok)
+			}
+		})
+	}
+}
+
+func TestConvertTo(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		desired string
+		actual  string
+		want    hcloud.ImageType
+	}{
+		"BackupToSnapshot": {
+			reason:  "A backup meant to be a snapshot is converted.",
+			desired: "snapshot",
+			actual:  "backup",
+			want:    hcloud.ImageTypeSnapshot,
+		},
+		"Snapshot": {
+			reason:  "A snapshot is never converted.",
+			desired: "snapshot",
+			actual:  "snapshot",
+		},
+		"SnapshotToBackup": {
+			reason:  "Snapshots cannot be converted into backups.",
+			desired: "backup",
+			actual:  "snapshot",
+		},
+		"Backup": {
+			reason:  "A backup meant to stay a backup is not converted.",
+			desired: "backup",
+			actual:  "backup",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := convertTo(tc.desired, tc.actual); got != tc.want {
+				t.Errorf("\n%s\nconvertTo(...): want %q, got %q\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,412 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"net"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/yaskoo/provider-hetzner/apis/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/features"
+)
+
+const (
+	errNotLoadBalancer = "managed resource is not a LoadBalancer custom resource"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errGetPC           = "cannot get ProviderConfig"
+	errGetCreds        = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errChangeProtection = "cannot change LoadBalancer protection"
+	errChangeType       = "cannot change LoadBalancer type"
+	errChangeAlgorithm  = "cannot change LoadBalancer algorithm"
+	errPublicInterface  = "cannot toggle LoadBalancer public interface"
+	errDetachNetwork    = "cannot detach LoadBalancer from Network"
+	errAttachNetwork    = "cannot attach LoadBalancer to Network"
+	errDeleteService    = "cannot delete LoadBalancer service"
+	errUpdateService    = "cannot update LoadBalancer service"
+	errAddService       = "cannot add LoadBalancer service"
+	errRemoveTarget     = "cannot remove LoadBalancer target"
+	errAddTarget        = "cannot add LoadBalancer target"
+	errLoadBalancerGone = "LoadBalancer no longer exists"
+)
+
+// A HCloudService is the interface to the Hetzner cloud API.
+type HCloudService struct {
+	client *hcloud.Client
+}
+
+var (
+	hCloudService = func(creds []byte) (*HCloudService, error) {
+		return &HCloudService{
+			client: hcloud.NewClient(hcloud.WithToken(string(creds))),
+		}, nil
+	}
+)
+
+// Setup adds a controller that reconciles LoadBalancer managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.LoadBalancerGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.LoadBalancerGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: hCloudService}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.LoadBalancer{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (*HCloudService, error)
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return nil, errors.New(errNotLoadBalancer)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service *HCloudService
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLoadBalancer)
+	}
+
+	lb, _, err := c.service.client.LoadBalancer.GetByName(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	exists := lb != nil && lb.ID > 0
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.Id = lb.ID
+	cr.Status.AtProvider.Created = &metav1.Time{Time: lb.Created}
+	cr.Status.AtProvider.IPv4 = ipString(lb.PublicNet.IPv4.IP)
+	cr.Status.AtProvider.IPv6 = ipString(lb.PublicNet.IPv6.IP)
+	cr.Status.AtProvider.PrivateIPv4 = ""
+	if len(lb.PrivateNet) > 0 {
+		cr.Status.AtProvider.PrivateIPv4 = ipString(lb.PrivateNet[0].IP)
+	}
+
+	lp := cr.Spec.ForProvider
+	addServices, updateServices, removeServices := diffServices(lp.Services, lb.Services)
+	addTargets, removeTargets := diffTargets(lp.Targets, lb.Targets)
+
+	upToDate := util.LabelsUpToDate(lp.Labels, lb.Labels) &&
+		typeUpToDate(lp.Type, lb.LoadBalancerType) &&
+		algorithmUpToDate(lp.Algorithm, lb.Algorithm) &&
+		publicInterfaceUpToDate(lp.PublicInterface, lb.PublicNet) &&
+		networkAttached(lp, lb.PrivateNet) &&
+		len(networksToDetach(lp, lb.PrivateNet)) == 0 &&
+		len(addServices)+len(updateServices)+len(removeServices) == 0 &&
+		len(addTargets)+len(removeTargets) == 0 &&
+		util.ProtectionUpToDate(lp.Protection.GetDelete(), lb.Protection.Delete)
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: connectionDetails(cr.Status.AtProvider),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLoadBalancer)
+	}
+
+	// Services and targets are added one by one on the following Update, the
+	// same way they are reconciled afterwards.
+	_, _, err := c.service.client.LoadBalancer.Create(ctx, toLoadBalancerCreateOpts(meta.GetExternalName(cr), cr.Spec.ForProvider))
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, err
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { //nolint:gocyclo
+	cr, ok := mg.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLoadBalancer)
+	}
+
+	lb, _, err := c.service.client.LoadBalancer.GetByID(ctx, cr.Status.AtProvider.Id)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if lb == nil {
+		return managed.ExternalUpdate{}, errors.New(errLoadBalancerGone)
+	}
+
+	lp := cr.Spec.ForProvider
+	labels := lp.Labels
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	if _, _, err := c.service.client.LoadBalancer.Update(ctx, lb, hcloud.LoadBalancerUpdateOpts{
+		Labels: labels,
+	}); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if !util.ProtectionUpToDate(lp.Protection.GetDelete(), lb.Protection.Delete) {
+		action, _, err := c.service.client.LoadBalancer.ChangeProtection(ctx, lb, hcloud.LoadBalancerChangeProtectionOpts{
+			Delete: lp.Protection.GetDelete(),
+		})
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeProtection)
+		}
+	}
+
+	if !typeUpToDate(lp.Type, lb.LoadBalancerType) {
+		action, _, err := c.service.client.LoadBalancer.ChangeType(ctx, lb, hcloud.LoadBalancerChangeTypeOpts{
+			LoadBalancerType: &hcloud.LoadBalancerType{ID: int(lp.Type.IntVal), Name: lp.Type.StrVal},
+		})
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeType)
+		}
+	}
+
+	if !algorithmUpToDate(lp.Algorithm, lb.Algorithm) {
+		action, _, err := c.service.client.LoadBalancer.ChangeAlgorithm(ctx, lb, hcloud.LoadBalancerChangeAlgorithmOpts{
+			Type: hcloud.LoadBalancerAlgorithmType(*lp.Algorithm),
+		})
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeAlgorithm)
+		}
+	}
+
+	if !publicInterfaceUpToDate(lp.PublicInterface, lb.PublicNet) {
+		toggle := c.service.client.LoadBalancer.EnablePublicInterface
+		if lb.PublicNet.Enabled {
+			toggle = c.service.client.LoadBalancer.DisablePublicInterface
+		}
+		action, _, err := toggle(ctx, lb)
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPublicInterface)
+		}
+	}
+
+	// Networks are reconciled before the targets, which may need them to be
+	// reached through their private IPs.
+	for _, network := range networksToDetach(lp, lb.PrivateNet) {
+		action, _, err := c.service.client.LoadBalancer.DetachFromNetwork(ctx, lb, hcloud.LoadBalancerDetachFromNetworkOpts{
+			Network: network,
+		})
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDetachNetwork)
+		}
+	}
+
+	if lp.Network != nil && !networkAttached(lp, lb.PrivateNet) {
+		opts := hcloud.LoadBalancerAttachToNetworkOpts{
+			Network: &hcloud.Network{ID: *lp.Network},
+		}
+		if lp.NetworkIP != nil {
+			opts.IP = net.ParseIP(*lp.NetworkIP)
+		}
+		action, _, err := c.service.client.LoadBalancer.AttachToNetwork(ctx, lb, opts)
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttachNetwork)
+		}
+	}
+
+	addServices, updateServices, removeServices := diffServices(lp.Services, lb.Services)
+	for _, port := range removeServices {
+		action, _, err := c.service.client.LoadBalancer.DeleteService(ctx, lb, port)
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteService)
+		}
+	}
+
+	for _, s := range updateServices {
+		action, _, err := c.service.client.LoadBalancer.UpdateService(ctx, lb, s.ListenPort, toUpdateServiceOpts(s))
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateService)
+		}
+	}
+
+	for _, s := range addServices {
+		action, _, err := c.service.client.LoadBalancer.AddService(ctx, lb, toAddServiceOpts(s))
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddService)
+		}
+	}
+
+	addTargets, removeTargets := diffTargets(lp.Targets, lb.Targets)
+	for _, t := range removeTargets {
+		if err := c.removeTarget(ctx, lb, t); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTarget)
+		}
+	}
+
+	for _, t := range addTargets {
+		if err := c.addTarget(ctx, lb, t); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTarget)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return errors.New(errNotLoadBalancer)
+	}
+
+	lb := &hcloud.LoadBalancer{ID: cr.Status.AtProvider.Id}
+	return util.DeleteUnlessProtected(cr, cr.Spec.ForProvider.Protection.GetDelete(), func() error {
+		_, err := c.service.client.LoadBalancer.Delete(ctx, lb)
+		return err
+	}, func() error {
+		action, _, err := c.service.client.LoadBalancer.ChangeProtection(ctx, lb, hcloud.LoadBalancerChangeProtectionOpts{
+			Delete: hcloud.Ptr(false),
+		})
+		return c.wait(ctx, action, err)
+	})
+}
+
+func (c *external) addTarget(ctx context.Context, lb *hcloud.LoadBalancer, t v1alpha1.LoadBalancerTarget) error {
+	var (
+		action *hcloud.Action
+		err    error
+	)
+
+	switch {
+	case t.Server != nil:
+		action, _, err = c.service.client.LoadBalancer.AddServerTarget(ctx, lb, hcloud.LoadBalancerAddServerTargetOpts{
+			Server:       &hcloud.Server{ID: *t.Server},
+			UsePrivateIP: t.UsePrivateIP,
+		})
+	case t.LabelSelector != nil:
+		action, _, err = c.service.client.LoadBalancer.AddLabelSelectorTarget(ctx, lb, hcloud.LoadBalancerAddLabelSelectorTargetOpts{
+			Selector:     *t.LabelSelector,
+			UsePrivateIP: t.UsePrivateIP,
+		})
+	case t.IP != nil:
+		action, _, err = c.service.client.LoadBalancer.AddIPTarget(ctx, lb, hcloud.LoadBalancerAddIPTargetOpts{
+			IP: net.ParseIP(*t.IP),
+		})
+	default:
+		return errors.Errorf("target of type %s has no %s set", t.Type, t.Type)
+	}
+	return c.wait(ctx, action, err)
+}
+
+func (c *external) removeTarget(ctx context.Context, lb *hcloud.LoadBalancer, t hcloud.LoadBalancerTarget) error {
+	var (
+		action *hcloud.Action
+		err    error
+	)
+
+	switch t.Type {
+	case hcloud.LoadBalancerTargetTypeServer:
+		action, _, err = c.service.client.LoadBalancer.RemoveServerTarget(ctx, lb, t.Server.Server)
+	case hcloud.LoadBalancerTargetTypeLabelSelector:
+		action, _, err = c.service.client.LoadBalancer.RemoveLabelSelectorTarget(ctx, lb, t.LabelSelector.Selector)
+	case hcloud.LoadBalancerTargetTypeIP:
+		action, _, err = c.service.client.LoadBalancer.RemoveIPTarget(ctx, lb, net.ParseIP(t.IP.IP))
+	}
+	return c.wait(ctx, action, err)
+}
+
+// wait waits for the action of a call that did not fail to finish.
+func (c *external) wait(ctx context.Context, action *hcloud.Action, err error) error {
+	if err != nil {
+		return err
+	}
+	return util.WaitForAction(ctx, c.service.client, action)
+}
@@ -0,0 +1,411 @@
+package loadbalancer
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func toLoadBalancerCreateOpts(name string, lp v1alpha1.LoadBalancerParameters) hcloud.LoadBalancerCreateOpts {
+	opts := hcloud.LoadBalancerCreateOpts{
+		Name: name,
+		LoadBalancerType: &hcloud.LoadBalancerType{
+			ID:   int(lp.Type.IntVal),
+			Name: lp.Type.StrVal,
+		},
+		Labels:          lp.Labels,
+		PublicInterface: lp.PublicInterface,
+	}
+
+	if lp.Algorithm != nil {
+		opts.Algorithm = &hcloud.LoadBalancerAlgorithm{
+			Type: hcloud.LoadBalancerAlgorithmType(*lp.Algorithm),
+		}
+	}
+
+	if lp.Location != nil {
+		opts.Location = &hcloud.Location{
+			ID:   int(lp.Location.IntVal),
+			Name: lp.Location.StrVal,
+		}
+	}
+
+	if lp.NetworkZone != nil {
+		opts.NetworkZone = hcloud.NetworkZone(*lp.NetworkZone)
+	}
+
+	// A fixed IP can only be requested when attaching to the Network later on.
+	if lp.Network != nil && lp.NetworkIP == nil {
+		opts.Network = &hcloud.Network{
+			ID: *lp.Network,
+		}
+	}
+	return opts
+}
+
+func typeUpToDate(desired intstr.IntOrString, actual *hcloud.LoadBalancerType) bool {
+	if actual == nil {
+		return false
+	}
+
+	if desired.Type == intstr.Int {
+		return int(desired.IntVal) == actual.ID
+	}
+	return desired.StrVal == actual.Name
+}
+
+func algorithmUpToDate(desired *string, actual hcloud.LoadBalancerAlgorithm) bool {
+	return desired == nil || *desired == string(actual.Type)
+}
+
+func publicInterfaceUpToDate(desired *bool, actual hcloud.LoadBalancerPublicNet) bool {
+	return (desired == nil || *desired) == actual.Enabled
+}
+
+// networksToDetach returns the Networks the LoadBalancer is attached to other
+// than the desired one, or the desired one itself if it has the wrong IP.
+func networksToDetach(lp v1alpha1.LoadBalancerParameters, actual []hcloud.LoadBalancerPrivateNet) []*hcloud.Network {
+	var detach []*hcloud.Network
+	for _, pn := range actual {
+		if lp.Network == nil || *lp.Network != pn.Network.ID ||
+			(lp.NetworkIP != nil && !pn.IP.Equal(net.ParseIP(*lp.NetworkIP))) {
+			detach = append(detach, pn.Network)
+		}
+	}
+	return detach
+}
+
+func networkAttached(lp v1alpha1.LoadBalancerParameters, actual []hcloud.LoadBalancerPrivateNet) bool {
+	if lp.Network == nil {
+		return true
+	}
+
+	for _, pn := range actual {
+		if pn.Network.ID == *lp.Network {
+			return lp.NetworkIP == nil || pn.IP.Equal(net.ParseIP(*lp.NetworkIP))
+		}
+	}
+	return false
+}
+
+// diffServices returns the services to add, to update and the listen ports
+// of the services to delete so that the actual services match the desired
+// ones. Services are identified by their listen port.
+func diffServices(desired []v1alpha1.LoadBalancerService, actual []hcloud.LoadBalancerService) (add, update []v1alpha1.LoadBalancerService, remove []int) {
+	existing := make(map[int]hcloud.LoadBalancerService, len(actual))
+	for _, s := range actual {
+		existing[s.ListenPort] = s
+	}
+
+	wanted := make(map[int]bool, len(desired))
+	for _, s := range desired {
+		wanted[s.ListenPort] = true
+
+		a, ok := existing[s.ListenPort]
+		switch {
+		case !ok:
+			add = append(add, s)
+		case !serviceUpToDate(s, a):
+			update = append(update, s)
+		}
+	}
+
+	for _, s := range actual {
+		if !wanted[s.ListenPort] {
+			remove = append(remove, s.ListenPort)
+		}
+	}
+	return add, update, remove
+}
+
+// serviceUpToDate compares only the fields set in the desired service, as
+// Hetzner fills in defaults for the ones left out.
+func serviceUpToDate(desired v1alpha1.LoadBalancerService, actual hcloud.LoadBalancerService) bool {
+	if desired.Protocol != string(actual.Protocol) || desired.DestinationPort != actual.DestinationPort {
+		return false
+	}
+
+	if desired.Proxyprotocol != nil && *desired.Proxyprotocol != actual.Proxyprotocol {
+		return false
+	}
+
+	if desired.HTTP != nil && !serviceHTTPUpToDate(*desired.HTTP, actual.HTTP) {
+		return false
+	}
+
+	return desired.HealthCheck == nil || healthCheckUpToDate(*desired.HealthCheck, actual.HealthCheck)
+}
+
+func serviceHTTPUpToDate(desired v1alpha1.LoadBalancerServiceHTTP, actual hcloud.LoadBalancerServiceHTTP) bool {
+	if desired.CookieName != nil && *desired.CookieName != actual.CookieName {
+		return false
+	}
+
+	if desired.CookieLifetime != nil && seconds(*desired.CookieLifetime) != actual.CookieLifetime {
+		return false
+	}
+
+	if desired.RedirectHTTP != nil && *desired.RedirectHTTP != actual.RedirectHTTP {
+		return false
+	}
+
+	if desired.StickySessions != nil && *desired.StickySessions != actual.StickySessions {
+		return false
+	}
+
+	if desired.Certificates == nil {
+		return true
+	}
+
+	certificates := make([]int, len(actual.Certificates))
+	for i, c := range actual.Certificates {
+		certificates[i] = c.ID
+	}
+	return sameInts(desired.Certificates, certificates)
+}
+
+func healthCheckUpToDate(desired v1alpha1.LoadBalancerHealthCheck, actual hcloud.LoadBalancerServiceHealthCheck) bool {
+	if desired.Protocol != string(actual.Protocol) ||
+		desired.Port != actual.Port ||
+		seconds(desired.Interval) != actual.Interval ||
+		seconds(desired.Timeout) != actual.Timeout ||
+		desired.Retries != actual.Retries {
+		return false
+	}
+
+	if desired.HTTP == nil {
+		return true
+	}
+
+	if actual.HTTP == nil {
+		return false
+	}
+
+	d, a := desired.HTTP, actual.HTTP
+	return (d.Domain == nil || *d.Domain == a.Domain) &&
+		(d.Path == nil || *d.Path == a.Path) &&
+		(d.Response == nil || *d.Response == a.Response) &&
+		(d.TLS == nil || *d.TLS == a.TLS) &&
+		(d.StatusCodes == nil || sameStrings(d.StatusCodes, a.StatusCodes))
+}
+
+func toAddServiceOpts(s v1alpha1.LoadBalancerService) hcloud.LoadBalancerAddServiceOpts {
+	opts := hcloud.LoadBalancerAddServiceOpts{
+		Protocol:        hcloud.LoadBalancerServiceProtocol(s.Protocol),
+		ListenPort:      hcloud.Ptr(s.ListenPort),
+		DestinationPort: hcloud.Ptr(s.DestinationPort),
+		Proxyprotocol:   s.Proxyprotocol,
+	}
+
+	if s.HTTP != nil {
+		opts.HTTP = &hcloud.LoadBalancerAddServiceOptsHTTP{
+			CookieName:     s.HTTP.CookieName,
+			CookieLifetime: secondsPtr(s.HTTP.CookieLifetime),
+			Certificates:   toCertificates(s.HTTP.Certificates),
+			RedirectHTTP:   s.HTTP.RedirectHTTP,
+			StickySessions: s.HTTP.StickySessions,
+		}
+	}
+
+	if hc := s.HealthCheck; hc != nil {
+		opts.HealthCheck = &hcloud.LoadBalancerAddServiceOptsHealthCheck{
+			Protocol: hcloud.LoadBalancerServiceProtocol(hc.Protocol),
+			Port:     hcloud.Ptr(hc.Port),
+			Interval: hcloud.Ptr(seconds(hc.Interval)),
+			Timeout:  hcloud.Ptr(seconds(hc.Timeout)),
+			Retries:  hcloud.Ptr(hc.Retries),
+		}
+
+		if hc.HTTP != nil {
+			opts.HealthCheck.HTTP = &hcloud.LoadBalancerAddServiceOptsHealthCheckHTTP{
+				Domain:      hc.HTTP.Domain,
+				Path:        hc.HTTP.Path,
+				Response:    hc.HTTP.Response,
+				StatusCodes: hc.HTTP.StatusCodes,
+				TLS:         hc.HTTP.TLS,
+			}
+		}
+	}
+	return opts
+}
+
+func toUpdateServiceOpts(s v1alpha1.LoadBalancerService) hcloud.LoadBalancerUpdateServiceOpts {
+	opts := hcloud.LoadBalancerUpdateServiceOpts{
+		Protocol:        hcloud.LoadBalancerServiceProtocol(s.Protocol),
+		DestinationPort: hcloud.Ptr(s.DestinationPort),
+		Proxyprotocol:   s.Proxyprotocol,
+	}
+
+	if s.HTTP != nil {
+		opts.HTTP = &hcloud.LoadBalancerUpdateServiceOptsHTTP{
+			CookieName:     s.HTTP.CookieName,
+			CookieLifetime: secondsPtr(s.HTTP.CookieLifetime),
+			Certificates:   toCertificates(s.HTTP.Certificates),
+			RedirectHTTP:   s.HTTP.RedirectHTTP,
+			StickySessions: s.HTTP.StickySessions,
+		}
+	}
+
+	if hc := s.HealthCheck; hc != nil {
+		opts.HealthCheck = &hcloud.LoadBalancerUpdateServiceOptsHealthCheck{
+			Protocol: hcloud.LoadBalancerServiceProtocol(hc.Protocol),
+			Port:     hcloud.Ptr(hc.Port),
+			Interval: hcloud.Ptr(seconds(hc.Interval)),
+			Timeout:  hcloud.Ptr(seconds(hc.Timeout)),
+			Retries:  hcloud.Ptr(hc.Retries),
+		}
+
+		if hc.HTTP != nil {
+			opts.HealthCheck.HTTP = &hcloud.LoadBalancerUpdateServiceOptsHealthCheckHTTP{
+				Domain:      hc.HTTP.Domain,
+				Path:        hc.HTTP.Path,
+				Response:    hc.HTTP.Response,
+				StatusCodes: hc.HTTP.StatusCodes,
+				TLS:         hc.HTTP.TLS,
+			}
+		}
+	}
+	return opts
+}
+
+// diffTargets returns the targets to add and to remove so that the actual
+// targets match the desired ones. A target whose use of the private IP
+// changed is both removed and added again.
+func diffTargets(desired []v1alpha1.LoadBalancerTarget, actual []hcloud.LoadBalancerTarget) (add []v1alpha1.LoadBalancerTarget, remove []hcloud.LoadBalancerTarget) {
+	existing := make(map[string]hcloud.LoadBalancerTarget, len(actual))
+	for _, t := range actual {
+		existing[actualTargetKey(t)] = t
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, t := range desired {
+		key := desiredTargetKey(t)
+		wanted[key] = true
+
+		a, ok := existing[key]
+		switch {
+		case !ok:
+			add = append(add, t)
+		case t.UsePrivateIP != nil && *t.UsePrivateIP != a.UsePrivateIP:
+			add = append(add, t)
+			remove = append(remove, a)
+		}
+	}
+
+	for _, t := range actual {
+		if !wanted[actualTargetKey(t)] {
+			remove = append(remove, t)
+		}
+	}
+	return add, remove
+}
+
+func desiredTargetKey(t v1alpha1.LoadBalancerTarget) string {
+	switch {
+	case t.Type == string(hcloud.LoadBalancerTargetTypeServer) && t.Server != nil:
+		return fmt.Sprintf("%s/%d", t.Type, *t.Server)
+	case t.Type == string(hcloud.LoadBalancerTargetTypeLabelSelector) && t.LabelSelector != nil:
+		return t.Type + "/" + *t.LabelSelector
+	case t.Type == string(hcloud.LoadBalancerTargetTypeIP) && t.IP != nil:
+		return t.Type + "/" + net.ParseIP(*t.IP).String()
+	}
+	return t.Type
+}
+
+func actualTargetKey(t hcloud.LoadBalancerTarget) string {
+	switch {
+	case t.Type == hcloud.LoadBalancerTargetTypeServer && t.Server != nil && t.Server.Server != nil:
+		return fmt.Sprintf("%s/%d", t.Type, t.Server.Server.ID)
+	case t.Type == hcloud.LoadBalancerTargetTypeLabelSelector && t.LabelSelector != nil:
+		return string(t.Type) + "/" + t.LabelSelector.Selector
+	case t.Type == hcloud.LoadBalancerTargetTypeIP && t.IP != nil:
+		return string(t.Type) + "/" + net.ParseIP(t.IP.IP).String()
+	}
+	return string(t.Type)
+}
+
+func toCertificates(ids []int) []*hcloud.Certificate {
+	if ids == nil {
+		return nil
+	}
+
+	certificates := make([]*hcloud.Certificate, len(ids))
+	for i, id := range ids {
+		certificates[i] = &hcloud.Certificate{ID: id}
+	}
+	return certificates
+}
+
+// ipString formats the IP, which is empty rather than "<nil>" when the IP is
+// unset, e.g. for a disabled public interface.
+func ipString(ip net.IP) string {
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
+
+// connectionDetails returns the non-empty addresses of the LoadBalancer.
+func connectionDetails(obs v1alpha1.LoadBalancerObservation) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	for key, ip := range map[string]string{
+		"publicIPv4":  obs.IPv4,
+		"publicIPv6":  obs.IPv6,
+		"privateIPv4": obs.PrivateIPv4,
+	} {
+		if ip != "" {
+			cd[key] = []byte(ip)
+		}
+	}
+	return cd
+}
+
+func seconds(s int) time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+func secondsPtr(s *int) *time.Duration {
+	if s == nil {
+		return nil
+	}
+	return hcloud.Ptr(seconds(*s))
+}
+
+func sameInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = append([]int(nil), a...), append([]int(nil), b...)
+	sort.Ints(a)
+	sort.Ints(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = append([]string(nil), a...), append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,185 @@
+package loadbalancer
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestDiffServices(t *testing.T) {
+	sticky := true
+
+	http := v1alpha1.LoadBalancerService{Protocol: "http", ListenPort: 80, DestinationPort: 8080}
+	https := v1alpha1.LoadBalancerService{
+		Protocol:        "https",
+		ListenPort:      443,
+		DestinationPort: 8080,
+		HTTP:            &v1alpha1.LoadBalancerServiceHTTP{StickySessions: &sticky, Certificates: []int{1}},
+	}
+
+	actualHTTP := hcloud.LoadBalancerService{
+		Protocol:        hcloud.LoadBalancerServiceProtocolHTTP,
+		ListenPort:      80,
+		DestinationPort: 8080,
+		HealthCheck: hcloud.LoadBalancerServiceHealthCheck{
+			Protocol: hcloud.LoadBalancerServiceProtocolHTTP,
+			Port:     8080,
+			Interval: 15 * time.Second,
+		},
+	}
+	actualTCP := hcloud.LoadBalancerService{Protocol: hcloud.LoadBalancerServiceProtocolTCP, ListenPort: 22, DestinationPort: 22}
+
+	type want struct {
+		add    []v1alpha1.LoadBalancerService
+		update []v1alpha1.LoadBalancerService
+		remove []int
+	}
+
+	cases := map[string]struct {
+		reason  string
+		desired []v1alpha1.LoadBalancerService
+		actual  []hcloud.LoadBalancerService
+		want    want
+	}{
+		"UpToDate": {
+			reason:  "Defaults filled in by Hetzner should not count as drift.",
+			desired: []v1alpha1.LoadBalancerService{http},
+			actual:  []hcloud.LoadBalancerService{actualHTTP},
+		},
+		"AddAndRemove": {
+			reason:  "Missing services should be added and unknown ones removed.",
+			desired: []v1alpha1.LoadBalancerService{http, https},
+			actual:  []hcloud.LoadBalancerService{actualHTTP, actualTCP},
+			want: want{
+				add:    []v1alpha1.LoadBalancerService{https},
+				remove: []int{22},
+			},
+		},
+		"Update": {
+			reason: "A service with a changed destination port should be updated in place.",
+			desired: []v1alpha1.LoadBalancerService{
+				{Protocol: "http", ListenPort: 80, DestinationPort: 9090},
+			},
+			actual: []hcloud.LoadBalancerService{actualHTTP},
+			want: want{
+				update: []v1alpha1.LoadBalancerService{
+					{Protocol: "http", ListenPort: 80, DestinationPort: 9090},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, update, remove := diffServices(tc.desired, tc.actual)
+			if diff := cmp.Diff(tc.want, want{add: add, update: update, remove: remove}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\ndiffServices(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDiffTargets(t *testing.T) {
+	server, selector, ip := 42, "app=web", "203.0.113.10"
+	private := true
+
+	actualServer := hcloud.LoadBalancerTarget{
+		Type:   hcloud.LoadBalancerTargetTypeServer,
+		Server: &hcloud.LoadBalancerTargetServer{Server: &hcloud.Server{ID: server}},
+	}
+	actualIP := hcloud.LoadBalancerTarget{
+		Type: hcloud.LoadBalancerTargetTypeIP,
+		IP:   &hcloud.LoadBalancerTargetIP{IP: net.ParseIP(ip).String()},
+	}
+
+	type want struct {
+		add    []v1alpha1.LoadBalancerTarget
+		remove []hcloud.LoadBalancerTarget
+	}
+
+	cases := map[string]struct {
+		reason  string
+		desired []v1alpha1.LoadBalancerTarget
+		actual  []hcloud.LoadBalancerTarget
+		want    want
+	}{
+		"UpToDate": {
+			reason: "Targets present on both sides should be left alone.",
+			desired: []v1alpha1.LoadBalancerTarget{
+				{Type: "server", Server: &server},
+				{Type: "ip", IP: &ip},
+			},
+			actual: []hcloud.LoadBalancerTarget{actualServer, actualIP},
+		},
+		"AddAndRemove": {
+			reason: "Missing targets should be added and unknown ones removed.",
+			desired: []v1alpha1.LoadBalancerTarget{
+				{Type: "server", Server: &server},
+				{Type: "label_selector", LabelSelector: &selector},
+			},
+			actual: []hcloud.LoadBalancerTarget{actualServer, actualIP},
+			want: want{
+				add:    []v1alpha1.LoadBalancerTarget{{Type: "label_selector", LabelSelector: &selector}},
+				remove: []hcloud.LoadBalancerTarget{actualIP},
+			},
+		},
+		"UsePrivateIPChanged": {
+			reason: "A target switching to its private IP should be replaced.",
+			desired: []v1alpha1.LoadBalancerTarget{
+				{Type: "server", Server: &server, UsePrivateIP: &private},
+			},
+			actual: []hcloud.LoadBalancerTarget{actualServer},
+			want: want{
+				add:    []v1alpha1.LoadBalancerTarget{{Type: "server", Server: &server, UsePrivateIP: &private}},
+				remove: []hcloud.LoadBalancerTarget{actualServer},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := diffTargets(tc.desired, tc.actual)
+			if diff := cmp.Diff(tc.want, want{add: add, remove: remove}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\ndiffTargets(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		ipv4   net.IP
+		ipv6   net.IP
+		want   managed.ConnectionDetails
+	}{
+		"Public": {
+			reason: "The addresses of the public interface are published.",
+			ipv4:   net.ParseIP("203.0.113.1"),
+			ipv6:   net.ParseIP("2001:db8::1"),
+			want: managed.ConnectionDetails{
+				"publicIPv4": []byte("203.0.113.1"),
+				"publicIPv6": []byte("2001:db8::1"),
+			},
+		},
+		"PublicInterfaceDisabled": {
+			reason: "Unset addresses are neither observed nor published.",
+			want:   managed.ConnectionDetails{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs := v1alpha1.LoadBalancerObservation{IPv4: ipString(tc.ipv4), IPv6: ipString(tc.ipv6)}
+			if diff := cmp.Diff(tc.want, connectionDetails(obs)); diff != "" {
+				t.Errorf("\n%s\nconnectionDetails(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+)
+
+// networkUpToDate reports whether the Network matches the spec. Whether its
+// Routes are exposed to vSwitch is not part of the Network and is checked
+// separately.
+func networkUpToDate(np v1alpha1.NetworkParameters, network *hcloud.Network) bool {
+	return util.LabelsUpToDate(np.Labels, network.Labels) &&
+		util.IPRangeUpToDate(np.IPRange, network.IPRange) &&
+		util.ProtectionUpToDate(np.Protection.GetDelete(), network.Protection.Delete)
+}
+
+// exposeRoutesToVSwitch is not supported by hcloud-go yet, so it is read and
+// written through plain API requests.
+type networkVSwitch struct {
+	ExposeRoutesToVSwitch bool `json:"expose_routes_to_vswitch"`
+}
+
+func (s *HCloudService) exposeRoutesToVSwitch(ctx context.Context, network *hcloud.Network) (bool, error) {
+	req, err := s.client.NewRequest(ctx, "GET", fmt.Sprintf("/networks/%d", network.ID), nil)
+	if err != nil {
+		return false, err
+	}
+
+	var body struct {
+		Network networkVSwitch `json:"network"`
+	}
+	if _, err := s.client.Do(req, &body); err != nil {
+		return false, err
+	}
+	return body.Network.ExposeRoutesToVSwitch, nil
+}
+
+func (s *HCloudService) setExposeRoutesToVSwitch(ctx context.Context, network *hcloud.Network, expose bool) error {
+	body, err := json.Marshal(networkVSwitch{ExposeRoutesToVSwitch: expose})
+	if err != nil {
+		return err
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", fmt.Sprintf("/networks/%d", network.ID), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(req, nil)
+	return err
+}
@@ -0,0 +1,62 @@
+package network
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestNetworkUpToDate(t *testing.T) {
+	_, ipRange, _ := net.ParseCIDR("10.0.0.0/16")
+
+	network := &hcloud.Network{
+		IPRange:    ipRange,
+		Labels:     map[string]string{"env": "prod"},
+		Protection: hcloud.NetworkProtection{Delete: true},
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.NetworkParameters
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "A Network matching the spec should be up to date.",
+			params: v1alpha1.NetworkParameters{IPRange: "10.0.0.0/16", Labels: map[string]string{"env": "prod"}},
+			want:   true,
+		},
+		"HostBitsSet": {
+			reason: "IP ranges should be compared by the network they denote.",
+			params: v1alpha1.NetworkParameters{IPRange: "10.0.0.1/16", Labels: map[string]string{"env": "prod"}},
+			want:   true,
+		},
+		"ExtendedRange": {
+			reason: "A Network whose IP range was extended in the spec should not be up to date.",
+			params: v1alpha1.NetworkParameters{IPRange: "10.0.0.0/8", Labels: map[string]string{"env": "prod"}},
+		},
+		"DifferentLabels": {
+			reason: "A Network with other labels should not be up to date.",
+			params: v1alpha1.NetworkParameters{IPRange: "10.0.0.0/16"},
+		},
+		"ProtectionUnset": {
+			reason: "Protection should be left alone when the spec does not set it.",
+			params: v1alpha1.NetworkParameters{IPRange: "10.0.0.0/16", Labels: map[string]string{"env": "prod"}, Protection: &v1alpha1.Protection{}},
+			want:   true,
+		},
+		"DifferentProtection": {
+			reason: "A Network protected other than the spec says should not be up to date.",
+			params: v1alpha1.NetworkParameters{IPRange: "10.0.0.0/16", Labels: map[string]string{"env": "prod"}, Protection: &v1alpha1.Protection{Delete: hcloud.Ptr(false)}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := networkUpToDate(tc.params, network); got != tc.want {
+				t.Errorf("\n%s\nnetworkUpToDate(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
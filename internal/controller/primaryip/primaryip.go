@@ -0,0 +1,335 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package primaryip
+
+import (
+	"context"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/yaskoo/provider-hetzner/apis/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/features"
+)
+
+const (
+	errNotPrimaryIP = "managed resource is not a PrimaryIP custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errAssignee = "cannot parse PrimaryIP assignee"
+	errAssign   = "cannot assign PrimaryIP"
+	errUnassign = "cannot unassign PrimaryIP"
+
+	errChangeProtection = "cannot change PrimaryIP protection"
+	errChangeDNSPtr     = "cannot change PrimaryIP reverse DNS entry"
+)
+
+// A HCloudService is the interface to the Hetzner cloud API.
+type HCloudService struct {
+	client *hcloud.Client
+}
+
+var (
+	hCloudService = func(creds []byte) (*HCloudService, error) {
+		return &HCloudService{
+			client: hcloud.NewClient(hcloud.WithToken(string(creds))),
+		}, nil
+	}
+)
+
+// Setup adds a controller that reconciles PrimaryIP managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PrimaryIPGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PrimaryIPGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: hCloudService}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.PrimaryIP{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (*HCloudService, error)
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.PrimaryIP)
+	if !ok {
+		return nil, errors.New(errNotPrimaryIP)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service *HCloudService
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PrimaryIP)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPrimaryIP)
+	}
+
+	pip, _, err := c.service.client.PrimaryIP.GetByName(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	exists := pip != nil && pip.ID > 0
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	assignee, err := assigneeID(cr.Spec.ForProvider.Assignee)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errAssignee)
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.Id = pip.ID
+	cr.Status.AtProvider.Created = &metav1.Time{Time: pip.Created}
+	cr.Status.AtProvider.IP = pip.IP.String()
+	cr.Status.AtProvider.AssigneeID = assignedTo(pip)
+	if pip.Datacenter != nil {
+		cr.Status.AtProvider.Datacenter = pip.Datacenter.Name
+	}
+
+	fp := cr.Spec.ForProvider
+	rdns, err := util.DNSPtrChanges(fp.RDNS, pip, util.HostNet(pip.IP), pip.Network)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errChangeDNSPtr)
+	}
+	upToDate := util.LabelsUpToDate(fp.Labels, pip.Labels) &&
+		(fp.AutoDelete == nil || *fp.AutoDelete == pip.AutoDelete) &&
+		assigneeUpToDate(assignee, assignedTo(pip)) &&
+		len(rdns) == 0 &&
+		util.ProtectionUpToDate(fp.Protection.GetDelete(), pip.Protection.Delete)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		ConnectionDetails: managed.ConnectionDetails{
+			"ip": []byte(pip.IP.String()),
+		},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PrimaryIP)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPrimaryIP)
+	}
+
+	opts, err := toPrimaryIPCreateOpts(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAssignee)
+	}
+
+	res, _, err := c.service.client.PrimaryIP.Create(ctx, opts)
+	if err == nil && res != nil {
+		err = util.WaitForAction(ctx, c.service.client, res.Action)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, err
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PrimaryIP)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPrimaryIP)
+	}
+
+	labels := cr.Spec.ForProvider.Labels
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	pip, _, err := c.service.client.PrimaryIP.Update(ctx, &hcloud.PrimaryIP{ID: cr.Status.AtProvider.Id}, hcloud.PrimaryIPUpdateOpts{
+		Labels:     &labels,
+		AutoDelete: cr.Spec.ForProvider.AutoDelete,
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if protect := cr.Spec.ForProvider.Protection.GetDelete(); protect != nil {
+		action, _, err := c.service.client.PrimaryIP.ChangeProtection(ctx, hcloud.PrimaryIPChangeProtectionOpts{
+			ID:     pip.ID,
+			Delete: *protect,
+		})
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeProtection)
+		}
+	}
+
+	rdns, err := util.DNSPtrChanges(cr.Spec.ForProvider.RDNS, pip, util.HostNet(pip.IP), pip.Network)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errChangeDNSPtr)
+	}
+	for _, change := range rdns {
+		action, _, err := c.service.client.PrimaryIP.ChangeDNSPtr(ctx, hcloud.PrimaryIPChangeDNSPtrOpts{
+			ID:     pip.ID,
+			IP:     change.IP.String(),
+			DNSPtr: change.Hostname,
+		})
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeDNSPtr)
+		}
+	}
+
+	assignee, err := assigneeID(cr.Spec.ForProvider.Assignee)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAssignee)
+	}
+	if assigneeUpToDate(assignee, cr.Status.AtProvider.AssigneeID) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if cr.Status.AtProvider.AssigneeID != nil {
+		action, _, err := c.service.client.PrimaryIP.Unassign(ctx, pip.ID)
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUnassign)
+		}
+	}
+
+	action, _, err := c.service.client.PrimaryIP.Assign(ctx, hcloud.PrimaryIPAssignOpts{
+		ID:           pip.ID,
+		AssigneeID:   *assignee,
+		AssigneeType: assigneeTypeServer,
+	})
+	if err == nil {
+		err = util.WaitForAction(ctx, c.service.client, action)
+	}
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAssign)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PrimaryIP)
+	if !ok {
+		return errors.New(errNotPrimaryIP)
+	}
+
+	assigned := cr.Status.AtProvider.AssigneeID != nil
+	return util.DeleteUnlessProtected(cr, cr.Spec.ForProvider.Protection.GetDelete(), func() error {
+		// Assigned PrimaryIPs cannot be deleted.
+		if assigned {
+			action, _, err := c.service.client.PrimaryIP.Unassign(ctx, cr.Status.AtProvider.Id)
+			if err == nil {
+				err = util.WaitForAction(ctx, c.service.client, action)
+			}
+			if err != nil {
+				return errors.Wrap(err, errUnassign)
+			}
+			assigned = false
+		}
+
+		_, err := c.service.client.PrimaryIP.Delete(ctx, &hcloud.PrimaryIP{ID: cr.Status.AtProvider.Id})
+		return err
+	}, func() error {
+		action, _, err := c.service.client.PrimaryIP.ChangeProtection(ctx, hcloud.PrimaryIPChangeProtectionOpts{
+			ID:     cr.Status.AtProvider.Id,
+			Delete: false,
+		})
+		if err != nil {
+			return err
+		}
+		return util.WaitForAction(ctx, c.service.client, action)
+	})
+}
@@ -0,0 +1,63 @@
+package primaryip
+
+import (
+	"strconv"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+// PrimaryIPs can only be assigned to Servers for now.
+const assigneeTypeServer = "server"
+
+func toPrimaryIPCreateOpts(name string, pp v1alpha1.PrimaryIPParameters) (hcloud.PrimaryIPCreateOpts, error) {
+	opts := hcloud.PrimaryIPCreateOpts{
+		Name:         name,
+		Type:         hcloud.PrimaryIPType(pp.Type),
+		AssigneeType: assigneeTypeServer,
+		AutoDelete:   pp.AutoDelete,
+		Labels:       pp.Labels,
+	}
+
+	assignee, err := assigneeID(pp.Assignee)
+	if err != nil {
+		return opts, err
+	}
+
+	// The Datacenter is mutually exclusive with assigning the PrimaryIP
+	// right away, as it is then created in the Datacenter of the Server.
+	if assignee != nil {
+		opts.AssigneeID = assignee
+	} else if pp.Datacenter != nil {
+		opts.Datacenter = *pp.Datacenter
+	}
+	return opts, nil
+}
+
+func assigneeID(id *string) (*int, error) {
+	if id == nil {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func assignedTo(pip *hcloud.PrimaryIP) *int {
+	if pip.AssigneeID == 0 {
+		return nil
+	}
+	id := pip.AssigneeID
+	return &id
+}
+
+// assigneeUpToDate leaves the assignment alone when no assignee is desired,
+// since a Server may claim the PrimaryIP through its publicNet instead.
+func assigneeUpToDate(desired, actual *int) bool {
+	if desired == nil {
+		return true
+	}
+	return actual != nil && *desired == *actual
+}
@@ -0,0 +1,100 @@
+package primaryip
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestToPrimaryIPCreateOpts(t *testing.T) {
+	server := "42"
+	serverID := 42
+	datacenter := "fsn1-dc14"
+	autoDelete := false
+
+	cases := map[string]struct {
+		reason  string
+		params  v1alpha1.PrimaryIPParameters
+		want    hcloud.PrimaryIPCreateOpts
+		wantErr bool
+	}{
+		"InDatacenter": {
+			reason: "An unassigned PrimaryIP should be created in the given Datacenter.",
+			params: v1alpha1.PrimaryIPParameters{
+				Type:       "ipv4",
+				Datacenter: &datacenter,
+				AutoDelete: &autoDelete,
+			},
+			want: hcloud.PrimaryIPCreateOpts{
+				Name:         "pip",
+				Type:         hcloud.PrimaryIPTypeIPv4,
+				AssigneeType: "server",
+				Datacenter:   datacenter,
+				AutoDelete:   &autoDelete,
+			},
+		},
+		"AssignedToServer": {
+			reason: "An assigned PrimaryIP should be created next to its Server, ignoring the Datacenter.",
+			params: v1alpha1.PrimaryIPParameters{
+				Type:       "ipv6",
+				Datacenter: &datacenter,
+				Assignee:   &server,
+			},
+			want: hcloud.PrimaryIPCreateOpts{
+				Name:         "pip",
+				Type:         hcloud.PrimaryIPTypeIPv6,
+				AssigneeType: "server",
+				AssigneeID:   &serverID,
+			},
+		},
+		"InvalidAssignee": {
+			reason: "An assignee that is not a numeric ID should be rejected.",
+			params: v1alpha1.PrimaryIPParameters{
+				Type:     "ipv4",
+				Assignee: &datacenter,
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := toPrimaryIPCreateOpts("pip", tc.params)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\ntoPrimaryIPCreateOpts(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntoPrimaryIPCreateOpts(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAssigneeUpToDate(t *testing.T) {
+	one, two := 1, 2
+
+	cases := map[string]struct {
+		desired *int
+		actual  *int
+		want    bool
+	}{
+		"Unmanaged":  {desired: nil, actual: &one, want: true},
+		"Unassigned": {desired: &one, actual: nil, want: false},
+		"Same":       {desired: &one, actual: &one, want: true},
+		"Different":  {desired: &one, actual: &two, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := assigneeUpToDate(tc.desired, tc.actual); got != tc.want {
+				t.Errorf("assigneeUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"context"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	"k8s.io/apimachinery/pkg/types"
+	"net"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/yaskoo/provider-hetzner/apis/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/features"
+)
+
+const (
+	errNotRoute     = "managed resource is not a Route custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errToRoute      = "cannot build Route from spec"
+	errDeleteRoute  = "cannot delete Route"
+	errAddRoute     = "cannot add Route"
+	errRestoreRoute = "cannot restore replaced Route"
+	errNoNetwork    = "Network does not exist"
+)
+
+// A HCloudService is the interface to the Hetzner cloud API.
+type HCloudService struct {
+	client *hcloud.Client
+}
+
+var (
+	hCloudService = func(creds []byte) (*HCloudService, error) {
+		return &HCloudService{
+			client: hcloud.NewClient(hcloud.WithToken(string(creds))),
+		}, nil
+	}
+)
+
+// Setup adds a controller that reconciles Route managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RouteGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RouteGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: hCloudService}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Route{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (*HCloudService, error)
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Route)
+	if !ok {
+		return nil, errors.New(errNotRoute)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service *HCloudService
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Route)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRoute)
+	}
+
+	network, _, err := c.service.client.Network.GetByID(ctx, cr.Spec.ForProvider.Network)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if network == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// A Route is identified by its destination. Look for the one applied
+	// last, so that a changed destination replaces it rather than leaving it
+	// behind.
+	destination := cr.Status.AtProvider.Destination
+	if destination == "" {
+		destination = cr.Spec.ForProvider.Destination
+	}
+
+	route := findRoute(network.Routes, destination)
+	if route == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.Destination = route.Destination.String()
+	cr.Status.AtProvider.Gateway = route.Gateway.String()
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  routeUpToDate(cr.Spec.ForProvider, route),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Route)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRoute)
+	}
+
+	route, err := toNetworkRoute(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errToRoute)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, c.addRoute(ctx, &hcloud.Network{ID: cr.Spec.ForProvider.Network}, route)
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Route)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRoute)
+	}
+
+	route, err := toNetworkRoute(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errToRoute)
+	}
+
+	network, _, err := c.service.client.Network.GetByID(ctx, cr.Spec.ForProvider.Network)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if network == nil {
+		return managed.ExternalUpdate{}, errors.New(errNoNetwork)
+	}
+
+	// Routes cannot be changed in place, so the applied one is replaced.
+	if applied := findRoute(network.Routes, cr.Status.AtProvider.Destination); applied != nil {
+		if err := c.replaceRoute(ctx, network, *applied, route); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	} else if err := c.addRoute(ctx, network, route); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAddRoute)
+	}
+
+	cr.Status.AtProvider.Destination = route.Destination.String()
+	cr.Status.AtProvider.Gateway = route.Gateway.String()
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Route)
+	if !ok {
+		return errors.New(errNotRoute)
+	}
+
+	_, destination, err := net.ParseCIDR(cr.Status.AtProvider.Destination)
+	if err != nil {
+		return err
+	}
+
+	return c.deleteRoute(ctx, &hcloud.Network{ID: cr.Spec.ForProvider.Network}, hcloud.NetworkRoute{
+		Destination: destination,
+		Gateway:     net.ParseIP(cr.Status.AtProvider.Gateway),
+	})
+}
+
+// replaceRoute replaces the applied Route so that a failure leaves it in
+// place. A Route to another destination is added before the applied one is
+// deleted. Routes to the same destination cannot coexist, so the applied one
+// is deleted first and added back if its replacement cannot be added.
+func (c *external) replaceRoute(ctx context.Context, network *hcloud.Network, applied, route hcloud.NetworkRoute) error {
+	if !sameDestination(applied, route) {
+		if !hasRoute(network.Routes, route) {
+			if err := c.addRoute(ctx, network, route); err != nil {
+				return errors.Wrap(err, errAddRoute)
+			}
+		}
+		return errors.Wrap(c.deleteRoute(ctx, network, applied), errDeleteRoute)
+	}
+
+	if err := c.deleteRoute(ctx, network, applied); err != nil {
+		return errors.Wrap(err, errDeleteRoute)
+	}
+	if err := c.addRoute(ctx, network, route); err != nil {
+		if err := c.addRoute(ctx, network, applied); err != nil {
+			return errors.Wrap(err, errRestoreRoute)
+		}
+		return errors.Wrap(err, errAddRoute)
+	}
+	return nil
+}
+
+func (c *external) addRoute(ctx context.Context, network *hcloud.Network, route hcloud.NetworkRoute) error {
+	action, _, err := c.service.client.Network.AddRoute(ctx, network, hcloud.NetworkAddRouteOpts{
+		Route: route,
+	})
+	if err != nil {
+		return err
+	}
+	return util.WaitForAction(ctx, c.service.client, action)
+}
+
+func (c *external) deleteRoute(ctx context.Context, network *hcloud.Network, route hcloud.NetworkRoute) error {
+	action, _, err := c.service.client.Network.DeleteRoute(ctx, network, hcloud.NetworkDeleteRouteOpts{
+		Route: route,
+	})
+	if err != nil {
+		return err
+	}
+	return util.WaitForAction(ctx, c.service.client, action)
+}
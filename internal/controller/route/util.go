@@ -0,0 +1,51 @@
+package route
+
+import (
+	"net"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+)
+
+func toNetworkRoute(rp v1alpha1.RouteParameters) (hcloud.NetworkRoute, error) {
+	_, destination, err := net.ParseCIDR(rp.Destination)
+	if err != nil {
+		return hcloud.NetworkRoute{}, err
+	}
+
+	gateway := net.ParseIP(rp.Gateway)
+	if gateway == nil {
+		return hcloud.NetworkRoute{}, errors.Errorf("invalid gateway IP %q", rp.Gateway)
+	}
+
+	return hcloud.NetworkRoute{
+		Destination: destination,
+		Gateway:     gateway,
+	}, nil
+}
+
+func findRoute(routes []hcloud.NetworkRoute, destination string) *hcloud.NetworkRoute {
+	for i := range routes {
+		if util.IPRangeUpToDate(destination, routes[i].Destination) {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func routeUpToDate(rp v1alpha1.RouteParameters, route *hcloud.NetworkRoute) bool {
+	return util.IPRangeUpToDate(rp.Destination, route.Destination) &&
+		route.Gateway.Equal(net.ParseIP(rp.Gateway))
+}
+
+// hasRoute reports whether the route has been applied already.
+func hasRoute(routes []hcloud.NetworkRoute, route hcloud.NetworkRoute) bool {
+	r := findRoute(routes, route.Destination.String())
+	return r != nil && r.Gateway.Equal(route.Gateway)
+}
+
+func sameDestination(a, b hcloud.NetworkRoute) bool {
+	return a.Destination.String() == b.Destination.String()
+}
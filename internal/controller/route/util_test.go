@@ -0,0 +1,157 @@
+package route
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func route(destination, gateway string) hcloud.NetworkRoute {
+	_, d, _ := net.ParseCIDR(destination)
+	return hcloud.NetworkRoute{Destination: d, Gateway: net.ParseIP(gateway)}
+}
+
+func TestToNetworkRoute(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		params  v1alpha1.RouteParameters
+		want    hcloud.NetworkRoute
+		wantErr bool
+	}{
+		"Valid": {
+			reason: "A valid spec should be converted to a Route.",
+			params: v1alpha1.RouteParameters{Destination: "10.100.1.0/24", Gateway: "10.0.1.1"},
+			want:   route("10.100.1.0/24", "10.0.1.1"),
+		},
+		"InvalidDestination": {
+			reason:  "A destination that is not a CIDR should be an error.",
+			params:  v1alpha1.RouteParameters{Destination: "10.100.1.0", Gateway: "10.0.1.1"},
+			wantErr: true,
+		},
+		"InvalidGateway": {
+			reason:  "A gateway that is not an IP should be an error.",
+			params:  v1alpha1.RouteParameters{Destination: "10.100.1.0/24", Gateway: "gateway"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := toNetworkRoute(tc.params)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\ntoNetworkRoute(...): want error %t, got %v\n", tc.reason, tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntoNetworkRoute(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRouteUpToDate(t *testing.T) {
+	r := route("10.100.1.0/24", "10.0.1.1")
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.RouteParameters
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "A Route matching the spec should be up to date.",
+			params: v1alpha1.RouteParameters{Destination: "10.100.1.0/24", Gateway: "10.0.1.1"},
+			want:   true,
+		},
+		"HostBitsSet": {
+			reason: "Destinations should be compared by the network they denote.",
+			params: v1alpha1.RouteParameters{Destination: "10.100.1.1/24", Gateway: "10.0.1.1"},
+			want:   true,
+		},
+		"DifferentDestination": {
+			reason: "A Route to another destination should not be up to date.",
+			params: v1alpha1.RouteParameters{Destination: "10.100.2.0/24", Gateway: "10.0.1.1"},
+		},
+		"DifferentGateway": {
+			reason: "A Route through another gateway should not be up to date.",
+			params: v1alpha1.RouteParameters{Destination: "10.100.1.0/24", Gateway: "10.0.1.2"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := routeUpToDate(tc.params, &r); got != tc.want {
+				t.Errorf("\n%s\nrouteUpToDate(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestHasRoute(t *testing.T) {
+	routes := []hcloud.NetworkRoute{
+		route("10.100.1.0/24", "10.0.1.1"),
+		route("10.100.2.0/24", "10.0.1.1"),
+	}
+
+	cases := map[string]struct {
+		reason string
+		route  hcloud.NetworkRoute
+		want   bool
+	}{
+		"Applied": {
+			reason: "A Route that is part of the Network should be found.",
+			route:  route("10.100.2.0/24", "10.0.1.1"),
+			want:   true,
+		},
+		"DifferentGateway": {
+			reason: "A Route to the same destination through another gateway has not been applied.",
+			route:  route("10.100.2.0/24", "10.0.1.2"),
+		},
+		"Missing": {
+			reason: "A Route to another destination has not been applied.",
+			route:  route("10.100.3.0/24", "10.0.1.1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := hasRoute(routes, tc.route); got != tc.want {
+				t.Errorf("\n%s\nhasRoute(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSameDestination(t *testing.T) {
+	applied := route("10.100.1.0/24", "10.0.1.1")
+
+	cases := map[string]struct {
+		reason string
+		route  hcloud.NetworkRoute
+		want   bool
+	}{
+		"SameDestination": {
+			reason: "A Route through another gateway to the same destination cannot coexist with the applied one.",
+			route:  route("10.100.1.0/24", "10.0.1.2"),
+			want:   true,
+		},
+		"NarrowerDestination": {
+			reason: "A Route to a narrower destination can be added next to the applied one.",
+			route:  route("10.100.1.0/25", "10.0.1.1"),
+		},
+		"DifferentDestination": {
+			reason: "A Route to another destination can be added next to the applied one.",
+			route:  route("10.100.2.0/24", "10.0.1.1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := sameDestination(applied, tc.route); got != tc.want {
+				t.Errorf("\n%s\nsameDestination(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
@@ -18,6 +18,12 @@ package server
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"strconv"
+	"time"
+
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/hetznercloud/hcloud-go/hcloud"
@@ -47,6 +53,38 @@ const (
 	errGetCreds     = "cannot get credentials"
 
 	errNewClient = "cannot create new Service"
+
+	errCreateOpts = "cannot build Server create options"
+	errServerGone = "Server no longer exists"
+	errDiff       = "cannot compare Server with its spec"
+
+	errRescale        = "cannot rescale Server"
+	errRebuild        = "cannot rebuild Server"
+	errPlacementGroup = "cannot change Server placement group"
+	errFirewalls      = "cannot change Server firewalls"
+	errNetworks       = "cannot change Server networks"
+	errVolumes        = "cannot change Server volumes"
+	errPower          = "cannot change Server power state"
+	errReboot         = "cannot reboot Server"
+	errProtection     = "cannot change Server protection"
+	errBackups        = "cannot reconcile Server backups"
+	errDNSPtr         = "cannot change Server reverse DNS entry"
+	errRescue         = "cannot change Server rescue system"
+	errISO            = "cannot change Server ISO"
+	errReset          = "cannot reset Server"
+	errUserData       = "cannot get Server user data"
+	errRecreate       = "cannot recreate Server"
+
+	errUnassignPrimaryIP = "cannot unassign PrimaryIP"
+)
+
+const (
+	// Rescaling and attaching take a few minutes, which exceeds the default
+	// reconcile timeout.
+	reconcileTimeout = 10 * time.Minute
+
+	defaultShutdownTimeout = 2 * time.Minute
+	statusPollInterval     = 5 * time.Second
 )
 
 // A HCloudService is the interface to the Hetzner cloud API.
@@ -79,6 +117,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 			newServiceFn: hCloudService}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(reconcileTimeout),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...))
 
@@ -128,12 +167,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{service: svc}, nil
+	return &external{kube: c.kube, service: svc}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
+	kube client.Client
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
 	service *HCloudService
@@ -146,28 +186,106 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	server, _, err := c.service.client.Server.GetByName(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	exists := server != nil
-	if exists {
-		cr.Status.AtProvider.Id = server.ID
-		cr.Status.AtProvider.Created = &metav1.Time{Time: server.Created}
-		cr.Status.AtProvider.DNS = server.PublicNet.IPv4.DNSPtr
-		cr.Status.AtProvider.IPv4 = server.PublicNet.IPv4.IP.String()
-		cr.Status.AtProvider.IPv6 = server.PublicNet.IPv6.IP.String()
-		status := string(server.Status)
-		cr.Status.AtProvider.Status = status
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.Id = server.ID
+	cr.Status.AtProvider.Created = &metav1.Time{Time: server.Created}
+	cr.Status.AtProvider.DNS = server.PublicNet.IPv4.DNSPtr
+	cr.Status.AtProvider.IPv4 = server.PublicNet.IPv4.IP.String()
+	cr.Status.AtProvider.IPv6 = server.PublicNet.IPv6.IP.String()
+	status := string(server.Status)
+	cr.Status.AtProvider.Status = status
+	cr.Status.AtProvider.Firewalls = firewallIDs(server)
+	cr.Status.AtProvider.PrivateNetworks = toPrivateNetworkObservations(server.PrivateNet)
+	cr.Status.AtProvider.Volumes = volumeIDs(server)
+	cr.Status.AtProvider.ServerType = ""
+	if server.ServerType != nil {
+		cr.Status.AtProvider.ServerType = server.ServerType.Name
+	}
+	cr.Status.AtProvider.Image = imageName(server.Image)
+	cr.Status.AtProvider.PlacementGroup = nil
+	if server.PlacementGroup != nil {
+		id := server.PlacementGroup.ID
+		cr.Status.AtProvider.PlacementGroup = &id
+	}
+	cr.Status.AtProvider.PrimaryIPv4 = optionalID(server.PublicNet.IPv4.ID)
+	cr.Status.AtProvider.PrimaryIPv6 = optionalID(server.PublicNet.IPv6.ID)
+
+	cr.Status.AtProvider.BackupWindow = server.BackupWindow
+	cr.Status.AtProvider.Backups = nil
+	if server.BackupWindow != "" {
+		backups, err := c.service.client.Image.AllWithOpts(ctx, hcloud.ImageListOpts{
+			Type:    []hcloud.ImageType{hcloud.ImageTypeBackup},
+			BoundTo: server,
+			Sort:    []string{"created:desc"},
+		})
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errBackups)
+		}
+		cr.Status.AtProvider.Backups = toBackupObservations(backups)
+	}
 
-		startAfterCreate := cr.Spec.ForProvider.StartAfterCreate == nil || *cr.Spec.ForProvider.StartAfterCreate
-		if status == string(hcloud.ServerStatusRunning) || (!startAfterCreate && status == string(hcloud.ServerStatusOff)) {
-			cr.Status.SetConditions(xpv1.Available())
+	// Generations set before the Server was first observed do not reboot it.
+	if cr.Status.AtProvider.RebootGeneration == nil {
+		var generation int64
+		if cr.Spec.ForProvider.RebootGeneration != nil {
+			generation = *cr.Spec.ForProvider.RebootGeneration
 		}
+		cr.Status.AtProvider.RebootGeneration = &generation
+	}
+
+	running := desiredRunning(cr.Spec.ForProvider)
+	if server.Status == hcloud.ServerStatusRunning || (!running && server.Status == hcloud.ServerStatusOff) {
+		cr.Status.SetConditions(xpv1.Available())
+	}
+
+	userDataUpToDate := c.observeUserData(ctx, cr)
+
+	if err := trackAttachments(cr.Spec.ForProvider, server, &cr.Status.AtProvider); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDiff)
+	}
+	d, err := diffServer(cr.Spec.ForProvider, server, cr.Status.AtProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDiff)
+	}
+	rdns, err := dnsPtrChanges(cr.Spec.ForProvider, server)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDNSPtr)
+	}
+
+	// Without the permission to rescale, a changed Server type is reported
+	// instead of being reconciled.
+	fp := cr.Spec.ForProvider
+	typeUpToDate := serverTypeUpToDate(fp.ServerType, server.ServerType)
+	rescale := fp.RescalePolicy == v1alpha1.RescalePolicyRescale
+	switch {
+	case typeUpToDate:
+		cr.Status.SetConditions(v1alpha1.ServerTypeUpToDate())
+	case !rescale:
+		cr.Status.SetConditions(v1alpha1.ServerTypeDrifted(server.ServerType.Name, fp.ServerType.String()))
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:    exists,
-		ResourceUpToDate:  exists && util.LabelsUpToDate(cr.Spec.ForProvider.Labels, server.Labels),
+		ResourceExists: true,
+		ResourceUpToDate: util.LabelsUpToDate(fp.Labels, server.Labels) && d.empty() &&
+			(typeUpToDate || !rescale) && imageUpToDate(desiredImage(fp), server.Image) &&
+			powerUpToDate(fp.PowerState, server.Status) &&
+			!rebootPending(fp.RebootGeneration, cr.Status.AtProvider.RebootGeneration) &&
+			util.ProtectionUpToDate(fp.Protection.GetDelete(), server.Protection.Delete) &&
+			util.ProtectionUpToDate(fp.Protection.GetRebuild(), server.Protection.Rebuild) &&
+			backupsUpToDate(fp.Backups, server.BackupWindow) && len(rdns) == 0 &&
+			(fp.Rescue != nil) == cr.Status.AtProvider.Rescue &&
+			isoUpToDate(fp.ISO, cr.Status.AtProvider.ISO, server.ISO) &&
+			userDataUpToDate,
 		ConnectionDetails: managed.ConnectionDetails{},
-	}, err
+	}, nil
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -176,19 +294,39 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotServer)
 	}
 
-	res, _, err := c.service.client.Server.Create(ctx, toServerCreateOpts(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	opts, err := toServerCreateOpts(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateOpts)
+	}
+	if opts.UserData, err = c.userData(ctx, opts.Name, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errUserData)
+	}
+	if err := c.keepPrimaryIPs(ctx, &opts, cr.Status.AtProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateOpts)
+	}
+	if err := c.resolveSSHKeys(ctx, opts.SSHKeys); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateOpts)
+	}
+
+	res, _, err := c.service.client.Server.Create(ctx, opts)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
 
-	connectionDetails := managed.ConnectionDetails{
-		"publicIPv4": []byte(res.Server.PublicNet.IPv4.IP.String()),
-		"dns":        []byte(res.Server.PublicNet.IPv4.DNSPtr),
-		"publicIPv6": []byte(res.Server.PublicNet.IPv6.IP.String()),
+	connectionDetails := managed.ConnectionDetails{}
+	if pn := res.Server.PublicNet; !pn.IPv4.IsUnspecified() {
+		connectionDetails["publicIPv4"] = []byte(pn.IPv4.IP.String())
+		connectionDetails["dns"] = []byte(pn.IPv4.DNSPtr)
+	}
+	if pn := res.Server.PublicNet; !pn.IPv6.IsUnspecified() {
+		connectionDetails["publicIPv6"] = []byte(pn.IPv6.IP.String())
 	}
 	if res.RootPassword != "" {
 		connectionDetails["rootPassword"] = []byte(res.RootPassword)
 	}
 	return managed.ExternalCreation{
 		ConnectionDetails: connectionDetails,
-	}, err
+	}, nil
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -197,13 +335,329 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotServer)
 	}
 
-	server := &hcloud.Server{
-		ID: cr.Status.AtProvider.Id,
+	server, _, err := c.service.client.Server.GetByID(ctx, cr.Status.AtProvider.Id)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if server == nil {
+		return managed.ExternalUpdate{}, errors.New(errServerGone)
+	}
+
+	// A recreated Server is updated once it was created again. Observe only
+	// reports drifted user data while recreating is allowed.
+	if cr.Status.GetCondition(v1alpha1.TypeUserDataDrift).Reason == v1alpha1.ReasonDrifted {
+		if err := c.recreate(ctx, server, cr.Spec.ForProvider); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRecreate)
+		}
+		cr.Status.AtProvider.UserDataHash = ""
+		return managed.ExternalUpdate{}, nil
+	}
+
+	labels := cr.Spec.ForProvider.Labels
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	if _, _, err := c.service.client.Server.Update(ctx, server, hcloud.ServerUpdateOpts{
+		Labels: labels,
+	}); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Protection is changed first so that lifting the rebuild protection
+	// takes effect before a rebuild is attempted below.
+	if err := c.updateProtection(ctx, server, cr.Spec.ForProvider.Protection); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errProtection)
+	}
+
+	d, err := diffServer(cr.Spec.ForProvider, server, cr.Status.AtProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDiff)
+	}
+
+	fp := cr.Spec.ForProvider
+	if fp.RescalePolicy == v1alpha1.RescalePolicyRescale && !serverTypeUpToDate(fp.ServerType, server.ServerType) {
+		if err := c.rescale(ctx, server, fp); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRescale)
+		}
+	}
+
+	// Rebuilding wipes the disk of the Server, so it has to be opted into.
+	// The new root password is only published if Update succeeds, so the
+	// remaining changes are left to the next reconcile.
+	if image := desiredImage(fp); rebuildOnImageChange(fp) && !imageUpToDate(image, server.Image) {
+		action, rootPassword, err := c.service.rebuild(ctx, server, toImage(*image))
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRebuild)
+		}
+		upd := managed.ExternalUpdate{}
+		if rootPassword != "" {
+			upd.ConnectionDetails = managed.ConnectionDetails{"rootPassword": []byte(rootPassword)}
+		}
+		return upd, nil
+	}
+
+	// The same goes for the root password of the rescue system.
+	if fp.Rescue != nil && !cr.Status.AtProvider.Rescue {
+		rescuePassword, err := c.enableRescue(ctx, server, *fp.Rescue)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRescue)
+		}
+		cr.Status.AtProvider.Rescue = true
+		return managed.ExternalUpdate{
+			ConnectionDetails: managed.ConnectionDetails{"rescuePassword": []byte(rescuePassword)},
+		}, nil
+	}
+
+	upd := managed.ExternalUpdate{}
+
+	if err := c.updatePlacementGroup(ctx, server, d); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPlacementGroup)
+	}
+	if err := c.updateFirewalls(ctx, server, d); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFirewalls)
+	}
+	if cr.Status.AtProvider.ManagedFirewalls, err = desiredFirewalls(fp); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFirewalls)
+	}
+	if err := c.updateNetworks(ctx, server, d.networks); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errNetworks)
+	}
+	if err := c.updateVolumes(ctx, server, d); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errVolumes)
+	}
+	if cr.Status.AtProvider.ManagedVolumes, err = desiredVolumes(fp); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errVolumes)
+	}
+
+	if err := c.updateDNSPtrs(ctx, server, fp); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDNSPtr)
+	}
+
+	if err := c.updateBackups(ctx, server, fp); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBackups)
+	}
+
+	// Rescue and ISO changes take effect on the next boot, so a running
+	// Server is reset once after both were changed.
+	rescueReset, err := c.disableRescue(ctx, server, fp, &cr.Status.AtProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRescue)
+	}
+	isoReset, err := c.updateISO(ctx, server, fp, &cr.Status.AtProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errISO)
+	}
+	if (rescueReset || isoReset) && server.Status == hcloud.ServerStatusRunning {
+		action, _, err := c.service.client.Server.Reset(ctx, server)
+		if err := c.wait(ctx, action, err); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errReset)
+		}
+	}
+
+	if err := c.updatePower(ctx, server, fp); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPower)
 	}
-	_, _, err := c.service.client.Server.Update(ctx, server, hcloud.ServerUpdateOpts{
-		Labels: cr.Spec.ForProvider.Labels,
+
+	// Only a Server that is running and meant to keep running is rebooted.
+	if rebootPending(fp.RebootGeneration, cr.Status.AtProvider.RebootGeneration) {
+		if server.Status == hcloud.ServerStatusRunning && desiredRunning(fp) {
+			action, _, err := c.service.client.Server.Reboot(ctx, server)
+			if err := c.wait(ctx, action, err); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errReboot)
+			}
+		}
+		generation := *fp.RebootGeneration
+		cr.Status.AtProvider.RebootGeneration = &generation
+	}
+
+	// Without the permission to rebuild, a changed Image is reported as the
+	// reason the Server is not synced once everything else was updated.
+	if image := desiredImage(fp); !imageUpToDate(image, server.Image) {
+		return managed.ExternalUpdate{}, errors.Errorf("image drifted from %s to %s, set rebuildOnImageChange to rebuild", imageName(server.Image), *image)
+	}
+
+	return upd, nil
+}
+
+// rescale changes the type of the Server, which has to be powered off for it.
+// A running Server is powered back on afterwards.
+func (c *external) rescale(ctx context.Context, server *hcloud.Server, sp v1alpha1.ServerParameters) error {
+	running := server.Status != hcloud.ServerStatusOff
+	if running {
+		action, _, err := c.service.client.Server.Poweroff(ctx, server)
+		if err := c.wait(ctx, action, err); err != nil {
+			return err
+		}
+	}
+
+	action, _, err := c.service.client.Server.ChangeType(ctx, server, hcloud.ServerChangeTypeOpts{
+		ServerType:  toServerType(sp.ServerType),
+		UpgradeDisk: sp.UpgradeDisk != nil && *sp.UpgradeDisk,
 	})
-	return managed.ExternalUpdate{}, err
+	if err := c.wait(ctx, action, err); err != nil {
+		return err
+	}
+
+	if running {
+		action, _, err := c.service.client.Server.Poweron(ctx, server)
+		return c.wait(ctx, action, err)
+	}
+	return nil
+}
+
+func (c *external) updatePower(ctx context.Context, server *hcloud.Server, sp v1alpha1.ServerParameters) error {
+	if powerUpToDate(sp.PowerState, server.Status) {
+		return nil
+	}
+
+	if *sp.PowerState == v1alpha1.PowerStateOn {
+		action, _, err := c.service.client.Server.Poweron(ctx, server)
+		return c.wait(ctx, action, err)
+	}
+	return c.shutdown(ctx, server, shutdownTimeout(sp))
+}
+
+// shutdown shuts the Server down gracefully and powers it off hard if it is
+// not off within the timeout. The shutdown action only sends an ACPI signal,
+// which the Server may take a while to comply with or ignore entirely.
+func (c *external) shutdown(ctx context.Context, server *hcloud.Server, timeout time.Duration) error {
+	action, _, err := c.service.client.Server.Shutdown(ctx, server)
+	if err := c.wait(ctx, action, err); err != nil {
+		return err
+	}
+
+	off, err := c.waitForStatus(ctx, server, hcloud.ServerStatusOff, timeout)
+	if err != nil || off {
+		return err
+	}
+
+	action, _, err = c.service.client.Server.Poweroff(ctx, server)
+	return c.wait(ctx, action, err)
+}
+
+// waitForStatus polls the Server until it has the given status or the timeout
+// has passed, reporting which of the two happened.
+func (c *external) waitForStatus(ctx context.Context, server *hcloud.Server, status hcloud.ServerStatus, timeout time.Duration) (bool, error) {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(statusPollInterval)
+	defer ticker.Stop()
+
+	for {
+		s, _, err := c.service.client.Server.GetByID(ctx, server.ID)
+		if err != nil {
+			return false, err
+		}
+		if s != nil && s.Status == status {
+			return true, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-deadline:
+			return false, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *external) updatePlacementGroup(ctx context.Context, server *hcloud.Server, d drift) error {
+	if !d.placementGroupChanged {
+		return nil
+	}
+
+	if server.PlacementGroup != nil {
+		action, _, err := c.service.client.Server.RemoveFromPlacementGroup(ctx, server)
+		if err := c.wait(ctx, action, err); err != nil {
+			return err
+		}
+	}
+
+	action, _, err := c.service.client.Server.AddToPlacementGroup(ctx, server, &hcloud.PlacementGroup{ID: *d.placementGroup})
+	return c.wait(ctx, action, err)
+}
+
+func (c *external) updateFirewalls(ctx context.Context, server *hcloud.Server, d drift) error {
+	resources := []hcloud.FirewallResource{{
+		Type:   hcloud.FirewallResourceTypeServer,
+		Server: &hcloud.FirewallResourceServer{ID: server.ID},
+	}}
+
+	for _, id := range d.removeFirewalls {
+		actions, _, err := c.service.client.Firewall.RemoveResources(ctx, &hcloud.Firewall{ID: id}, resources)
+		if err == nil {
+			err = util.WaitForActions(ctx, c.service.client, actions)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range d.applyFirewalls {
+		actions, _, err := c.service.client.Firewall.ApplyResources(ctx, &hcloud.Firewall{ID: id}, resources)
+		if err == nil {
+			err = util.WaitForActions(ctx, c.service.client, actions)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *external) updateNetworks(ctx context.Context, server *hcloud.Server, d privateNetDiff) error {
+	for _, id := range d.detach {
+		action, _, err := c.service.client.Server.DetachFromNetwork(ctx, server, hcloud.ServerDetachFromNetworkOpts{
+			Network: &hcloud.Network{ID: id},
+		})
+		if err := c.wait(ctx, action, err); err != nil {
+			return err
+		}
+	}
+
+	for _, pn := range d.attach {
+		action, _, err := c.service.client.Server.AttachToNetwork(ctx, server, hcloud.ServerAttachToNetworkOpts{
+			Network:  &hcloud.Network{ID: pn.network},
+			IP:       pn.ip,
+			AliasIPs: pn.aliasIPs,
+		})
+		if err := c.wait(ctx, action, err); err != nil {
+			return err
+		}
+	}
+
+	for _, pn := range d.aliases {
+		aliasIPs := pn.aliasIPs
+		if aliasIPs == nil {
+			aliasIPs = []net.IP{}
+		}
+		action, _, err := c.service.client.Server.ChangeAliasIPs(ctx, server, hcloud.ServerChangeAliasIPsOpts{
+			Network:  &hcloud.Network{ID: pn.network},
+			AliasIPs: aliasIPs,
+		})
+		if err := c.wait(ctx, action, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *external) updateVolumes(ctx context.Context, server *hcloud.Server, d drift) error {
+	for _, id := range d.detachVolumes {
+		action, _, err := c.service.client.Volume.Detach(ctx, &hcloud.Volume{ID: id})
+		if err := c.wait(ctx, action, err); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range d.attachVolumes {
+		action, _, err := c.service.client.Volume.Attach(ctx, &hcloud.Volume{ID: id}, server)
+		if err := c.wait(ctx, action, err); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -212,8 +666,348 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotServer)
 	}
 
-	_, err := c.service.client.Server.Delete(ctx, &hcloud.Server{
-		ID: cr.Status.AtProvider.Id,
+	server := &hcloud.Server{ID: cr.Status.AtProvider.Id}
+	return util.DeleteUnlessProtected(cr, cr.Spec.ForProvider.Protection.GetDelete(), func() error {
+		_, err := c.service.client.Server.Delete(ctx, server)
+		return err
+	}, func() error {
+		action, _, err := c.service.client.Server.ChangeProtection(ctx, server, hcloud.ServerChangeProtectionOpts{
+			Delete:  hcloud.Ptr(false),
+			Rebuild: hcloud.Ptr(false),
+		})
+		return c.wait(ctx, action, err)
 	})
+}
+
+// userData returns the user data the Server is created with, rendered and
+// encoded as desired.
+func (c *external) userData(ctx context.Context, name string, sp v1alpha1.ServerParameters) (string, error) {
+	data, err := c.userDataSource(ctx, sp)
+	if err != nil {
+		return "", err
+	}
+
+	if sp.UserDataTemplate != nil && *sp.UserDataTemplate {
+		values, err := toUserDataValues(name, sp)
+		if err != nil {
+			return "", err
+		}
+		if values.SSHKeys, err = c.userDataSSHKeys(ctx, sp); err != nil {
+			return "", err
+		}
+		if data, err = renderUserData(data, values); err != nil {
+			return "", err
+		}
+	}
+
+	return encodeUserData(data, sp.UserDataEncoding)
+}
+
+// userDataSource returns the user data as it is set in the spec, before it
+// is rendered as a template.
+func (c *external) userDataSource(ctx context.Context, sp v1alpha1.ServerParameters) (string, error) {
+	sources := 0
+	for _, set := range []bool{sp.UserData != nil, sp.UserDataSecretRef != nil, sp.UserDataConfigMapRef != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return "", errors.New("at most one of userData, userDataSecretRef and userDataConfigMapRef may be set")
+	}
+
+	switch {
+	case sp.UserData != nil:
+		return *sp.UserData, nil
+	case sp.UserDataSecretRef != nil:
+		v, err := util.GetSecretKey(ctx, c.kube, *sp.UserDataSecretRef)
+		if err != nil {
+			return "", err
+		}
+		return string(v), nil
+	case sp.UserDataConfigMapRef != nil:
+		return util.GetConfigMapKey(ctx, c.kube, *sp.UserDataConfigMapRef)
+	default:
+		return "", nil
+	}
+}
+
+// userDataSSHKeys returns the SSHKeys user data templates are rendered with.
+// Referenced SSHKeys are taken from their status, the ones set by ID or name
+// are looked up.
+func (c *external) userDataSSHKeys(ctx context.Context, sp v1alpha1.ServerParameters) ([]userDataSSHKey, error) {
+	keys := make([]userDataSSHKey, 0, len(sp.SSHKeyRefs))
+	referenced := make(map[string]bool, len(sp.SSHKeyRefs))
+	for _, ref := range sp.SSHKeyRefs {
+		key := &v1alpha1.SSHKey{}
+		if err := c.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, key); err != nil {
+			return nil, errors.Wrapf(err, "cannot get SSHKey %s", ref.Name)
+		}
+		referenced[strconv.Itoa(key.Status.AtProvider.Id)] = true
+		keys = append(keys, toUserDataSSHKey(key))
+	}
+
+	for _, idOrName := range desiredSSHKeys(sp) {
+		if referenced[idOrName] {
+			continue
+		}
+		key, _, err := c.service.client.SSHKey.Get(ctx, idOrName)
+		if err != nil {
+			return nil, err
+		}
+		if key == nil {
+			return nil, errors.Errorf("SSHKey %s does not exist", idOrName)
+		}
+		keys = append(keys, userDataSSHKey{
+			ID:          key.ID,
+			Name:        key.Name,
+			Fingerprint: key.Fingerprint,
+			PublicKey:   key.PublicKey,
+		})
+	}
+	return keys, nil
+}
+
+// observeUserData reports whether the user data is up to date. It is only
+// tracked while the Server may be recreated and is not being deleted, and
+// the user data of a Server that was created before it was tracked is
+// assumed to be the desired one. User data that cannot be read is reported
+// by the UserDataDrift condition rather than failing the observation.
+func (c *external) observeUserData(ctx context.Context, cr *v1alpha1.Server) bool {
+	if !recreateOnUserDataChange(cr.Spec.ForProvider) || meta.WasDeleted(cr) {
+		if cr.Status.AtProvider.UserDataHash != "" {
+			cr.Status.AtProvider.UserDataHash = ""
+			cr.Status.SetConditions(v1alpha1.UserDataUntracked())
+		}
+		return true
+	}
+
+	hash, err := c.userDataHash(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		cr.Status.SetConditions(v1alpha1.UserDataUnavailable(errors.Wrap(err, errUserData)))
+		return true
+	}
+	if cr.Status.AtProvider.UserDataHash == "" {
+		cr.Status.AtProvider.UserDataHash = hash
+	}
+	if cr.Status.AtProvider.UserDataHash != hash {
+		cr.Status.SetConditions(v1alpha1.UserDataDrifted())
+		return false
+	}
+	cr.Status.SetConditions(v1alpha1.UserDataUpToDate())
+	return true
+}
+
+// userDataHash returns the hash of the user data the Server is created with.
+// Templates are hashed before they are rendered, so that changes to the
+// values they refer to, e.g. labels or rotated SSHKeys, do not count as
+// changed user data.
+func (c *external) userDataHash(ctx context.Context, sp v1alpha1.ServerParameters) (string, error) {
+	data, err := c.userDataSource(ctx, sp)
+	if err != nil {
+		return "", err
+	}
+	if data, err = encodeUserData(data, sp.UserDataEncoding); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// recreate deletes the Server so that it is created again with the desired
+// user data. Its primary IPs can only be unassigned from a Server that is off,
+// and are kept for the new Server once unassigned.
+func (c *external) recreate(ctx context.Context, server *hcloud.Server, sp v1alpha1.ServerParameters) error {
+	if server.Status != hcloud.ServerStatusOff {
+		if err := c.shutdown(ctx, server, shutdownTimeout(sp)); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range []int{server.PublicNet.IPv4.ID, server.PublicNet.IPv6.ID} {
+		if id == 0 {
+			continue
+		}
+		action, _, err := c.service.client.PrimaryIP.Unassign(ctx, id)
+		if err := c.wait(ctx, action, err); err != nil {
+			return errors.Wrap(err, errUnassignPrimaryIP)
+		}
+	}
+
+	_, err := c.service.client.Server.Delete(ctx, server)
 	return err
 }
+
+// keepPrimaryIPs assigns the primary IPs of a recreated Server to the new one,
+// unless the spec chooses the primary IPs. They are only reused while they
+// exist and are unassigned.
+func (c *external) keepPrimaryIPs(ctx context.Context, opts *hcloud.ServerCreateOpts, obs v1alpha1.ServerObservation) error {
+	if opts.PublicNet == nil {
+		opts.PublicNet = &hcloud.ServerCreatePublicNet{EnableIPv4: true, EnableIPv6: true}
+	}
+	pn := opts.PublicNet
+
+	var err error
+	if pn.EnableIPv4 && pn.IPv4 == nil {
+		if pn.IPv4, err = c.unassignedPrimaryIP(ctx, obs.PrimaryIPv4); err != nil {
+			return err
+		}
+	}
+	if pn.EnableIPv6 && pn.IPv6 == nil {
+		if pn.IPv6, err = c.unassignedPrimaryIP(ctx, obs.PrimaryIPv6); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *external) unassignedPrimaryIP(ctx context.Context, id *int) (*hcloud.PrimaryIP, error) {
+	if id == nil {
+		return nil, nil
+	}
+	pip, _, err := c.service.client.PrimaryIP.GetByID(ctx, *id)
+	if err != nil || pip == nil || pip.AssigneeID != 0 {
+		return nil, err
+	}
+	return pip, nil
+}
+
+// enableRescue enables the rescue system and resets a running Server to boot
+// into it. It returns the root password of the rescue system.
+func (c *external) enableRescue(ctx context.Context, server *hcloud.Server, rescue v1alpha1.ServerRescue) (string, error) {
+	opts := hcloud.ServerEnableRescueOpts{Type: hcloud.ServerRescueType(rescue.Type)}
+	for _, key := range rescue.SSHKeys {
+		opts.SSHKeys = append(opts.SSHKeys, toSSHKey(key))
+	}
+	if err := c.resolveSSHKeys(ctx, opts.SSHKeys); err != nil {
+		return "", err
+	}
+
+	res, _, err := c.service.client.Server.EnableRescue(ctx, server, opts)
+	if err := c.wait(ctx, res.Action, err); err != nil {
+		return "", err
+	}
+	if server.Status == hcloud.ServerStatusRunning {
+		action, _, err := c.service.client.Server.Reset(ctx, server)
+		if err := c.wait(ctx, action, err); err != nil {
+			return "", errors.Wrap(err, errReset)
+		}
+	}
+	return res.RootPassword, nil
+}
+
+// disableRescue disables the rescue system once it is not desired anymore. It
+// reports whether the Server has to be reset to boot into its own system.
+func (c *external) disableRescue(ctx context.Context, server *hcloud.Server, sp v1alpha1.ServerParameters, obs *v1alpha1.ServerObservation) (bool, error) {
+	if sp.Rescue != nil || !obs.Rescue {
+		return false, nil
+	}
+
+	// The rescue system is disabled by Hetzner once booted into.
+	if server.RescueEnabled {
+		action, _, err := c.service.client.Server.DisableRescue(ctx, server)
+		if err := c.wait(ctx, action, err); err != nil {
+			return false, err
+		}
+	}
+	obs.Rescue = false
+	return true, nil
+}
+
+// resolveSSHKeys looks up the IDs of the SSHKeys referred to by name, as
+// Hetzner only accepts IDs.
+func (c *external) resolveSSHKeys(ctx context.Context, keys []*hcloud.SSHKey) error {
+	for _, key := range keys {
+		if key.ID != 0 {
+			continue
+		}
+		found, _, err := c.service.client.SSHKey.GetByName(ctx, key.Name)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return errors.Errorf("SSHKey %s does not exist", key.Name)
+		}
+		key.ID = found.ID
+	}
+	return nil
+}
+
+// updateISO attaches the desired ISO to the Server and detaches the one that
+// was attached once it is not desired anymore. It reports whether the Server
+// has to be reset for the change to take effect.
+func (c *external) updateISO(ctx context.Context, server *hcloud.Server, sp v1alpha1.ServerParameters, obs *v1alpha1.ServerObservation) (bool, error) {
+	if isoUpToDate(sp.ISO, obs.ISO, server.ISO) {
+		return false, nil
+	}
+	changed := server.ISO != nil || sp.ISO != nil
+	if server.ISO != nil {
+		action, _, err := c.service.client.Server.DetachISO(ctx, server)
+		if err := c.wait(ctx, action, err); err != nil {
+			return false, err
+		}
+	}
+	obs.ISO = ""
+	if sp.ISO != nil {
+		action, _, err := c.service.client.Server.AttachISO(ctx, server, toISO(*sp.ISO))
+		if err := c.wait(ctx, action, err); err != nil {
+			return false, err
+		}
+		obs.ISO = *sp.ISO
+	}
+	return changed, nil
+}
+
+// updateDNSPtrs sets the reverse DNS entries of the public IPs of the Server.
+func (c *external) updateDNSPtrs(ctx context.Context, server *hcloud.Server, sp v1alpha1.ServerParameters) error {
+	changes, err := dnsPtrChanges(sp, server)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		action, _, err := c.service.client.Server.ChangeDNSPtr(ctx, server, change.IP.String(), hcloud.Ptr(change.Hostname))
+		if err := c.wait(ctx, action, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateBackups enables or disables the backups of the Server.
+func (c *external) updateBackups(ctx context.Context, server *hcloud.Server, sp v1alpha1.ServerParameters) error {
+	if backupsUpToDate(sp.Backups, server.BackupWindow) {
+		return nil
+	}
+	if *sp.Backups {
+		var window string
+		if sp.BackupWindow != nil {
+			window = *sp.BackupWindow
+		}
+		action, _, err := c.service.client.Server.EnableBackup(ctx, server, window)
+		return c.wait(ctx, action, err)
+	}
+	action, _, err := c.service.client.Server.DisableBackup(ctx, server)
+	return c.wait(ctx, action, err)
+}
+
+// updateProtection changes the delete and rebuild protection of the Server
+// if either differs from the desired one.
+func (c *external) updateProtection(ctx context.Context, server *hcloud.Server, p *v1alpha1.ServerProtection) error {
+	del, rebuild := p.GetDelete(), p.GetRebuild()
+	if util.ProtectionUpToDate(del, server.Protection.Delete) &&
+		util.ProtectionUpToDate(rebuild, server.Protection.Rebuild) {
+		return nil
+	}
+	action, _, err := c.service.client.Server.ChangeProtection(ctx, server, hcloud.ServerChangeProtectionOpts{
+		Delete:  del,
+		Rebuild: rebuild,
+	})
+	return c.wait(ctx, action, err)
+}
+
+func (c *external) wait(ctx context.Context, action *hcloud.Action, err error) error {
+	if err != nil {
+		return err
+	}
+	return util.WaitForAction(ctx, c.service.client, action)
+}
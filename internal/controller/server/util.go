@@ -1,34 +1,45 @@
 package server
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/hetznercloud/hcloud-go/hcloud/schema"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
 	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
 )
 
-func toServerCreateOpts(name string, sp v1alpha1.ServerParameters) hcloud.ServerCreateOpts {
+func toServerCreateOpts(name string, sp v1alpha1.ServerParameters) (hcloud.ServerCreateOpts, error) {
 	opts := hcloud.ServerCreateOpts{
-		Name: name,
-		ServerType: &hcloud.ServerType{
-			ID:   int(sp.ServerType.IntVal),
-			Name: sp.ServerType.StrVal,
-		},
-		Image: &hcloud.Image{
-			ID:   int(sp.Image.IntVal),
-			Name: sp.Image.StrVal,
-		},
-		StartAfterCreate: sp.StartAfterCreate,
+		Name:             name,
+		ServerType:       toServerType(sp.ServerType),
+		StartAfterCreate: hcloud.Ptr(desiredRunning(sp)),
 		Automount:        sp.Automount,
 	}
 
-	if sp.SSHKeys != nil {
-		var sshKeys []*hcloud.SSHKey
-		for _, key := range *sp.SSHKeys {
-			sshKeys = append(sshKeys, &hcloud.SSHKey{
-				ID:   int(key.IntVal),
-				Name: key.StrVal,
-			})
-		}
-		opts.SSHKeys = sshKeys
+	image := desiredImage(sp)
+	if image == nil {
+		return opts, errors.New("image is neither set nor resolved from a reference")
+	}
+	opts.Image = toImage(*image)
+
+	for _, key := range desiredSSHKeys(sp) {
+		opts.SSHKeys = append(opts.SSHKeys, toSSHKey(key))
 	}
 
 	if sp.Location != nil {
@@ -45,63 +56,724 @@ func toServerCreateOpts(name string, sp v1alpha1.ServerParameters) hcloud.Server
 		}
 	}
 
-	if sp.UserData != nil {
-		opts.UserData = *sp.UserData
-	}
-
 	if sp.Labels != nil {
 		opts.Labels = sp.Labels
 	}
 
-	if sp.Volumes != nil {
-		var volumes []*hcloud.Volume
-		for _, v := range *sp.Volumes {
-			volumes = append(volumes, &hcloud.Volume{
-				ID: v,
-			})
+	volumes, err := toIDs(withDeprecatedIDs(sp.VolumeIDs, sp.Volumes))
+	if err != nil {
+		return opts, errors.Wrap(err, "invalid volumes")
+	}
+	for _, id := range volumes {
+		opts.Volumes = append(opts.Volumes, &hcloud.Volume{ID: id})
+	}
+
+	networks, err := toIDs(withDeprecatedIDs(sp.NetworkIDs, sp.Networks))
+	if err != nil {
+		return opts, errors.Wrap(err, "invalid networks")
+	}
+	for _, id := range networks {
+		opts.Networks = append(opts.Networks, &hcloud.Network{ID: id})
+	}
+
+	firewalls, err := toIDs(withDeprecatedIDs(sp.FirewallIDs, sp.Firewalls))
+	if err != nil {
+		return opts, errors.Wrap(err, "invalid firewalls")
+	}
+	for _, id := range firewalls {
+		opts.Firewalls = append(opts.Firewalls, &hcloud.ServerCreateFirewall{
+			Firewall: hcloud.Firewall{ID: id},
+		})
+	}
+
+	if pg := withDeprecatedID(sp.PlacementGroupID, sp.PlacementGroup); pg != nil {
+		id, err := strconv.Atoi(*pg)
+		if err != nil {
+			return opts, errors.Wrap(err, "invalid placementGroup")
 		}
-		opts.Volumes = volumes
+		opts.PlacementGroup = &hcloud.PlacementGroup{ID: id}
 	}
 
-	if sp.Networks != nil {
-		var nets []*hcloud.Network
-		for _, v := range *sp.Networks {
-			nets = append(nets, &hcloud.Network{
-				ID: v,
-			})
+	if sp.PublicNet != nil {
+		publicNet, err := toServerCreatePublicNet(*sp.PublicNet)
+		if err != nil {
+			return opts, err
 		}
-		opts.Networks = nets
+		opts.PublicNet = publicNet
+	}
+	return opts, nil
+}
+
+// toServerCreatePublicNet enables both address families unless disabled
+// explicitly and attaches the referenced PrimaryIPs, if any.
+func toServerCreatePublicNet(pn v1alpha1.PublicNetwork) (*hcloud.ServerCreatePublicNet, error) {
+	publicNet := &hcloud.ServerCreatePublicNet{
+		EnableIPv4: pn.EnableIPv4 == nil || *pn.EnableIPv4,
+		EnableIPv6: pn.EnableIPv6 == nil || *pn.EnableIPv6,
 	}
 
-	if sp.Firewalls != nil {
-		var firewalls []*hcloud.ServerCreateFirewall
-		for _, v := range *sp.Firewalls {
-			firewalls = append(firewalls, &hcloud.ServerCreateFirewall{
-				Firewall: hcloud.Firewall{
-					ID: v,
-				},
-			})
+	var err error
+	if publicNet.IPv4, err = primaryIP(withDeprecatedID(pn.IPv4ID, pn.IPv4)); err != nil {
+		return nil, errors.Wrap(err, "invalid ipv4 PrimaryIP")
+	}
+	if publicNet.IPv6, err = primaryIP(withDeprecatedID(pn.IPv6ID, pn.IPv6)); err != nil {
+		return nil, errors.Wrap(err, "invalid ipv6 PrimaryIP")
+	}
+	return publicNet, nil
+}
+
+func toServerType(idOrName intstr.IntOrString) *hcloud.ServerType {
+	return &hcloud.ServerType{
+		ID:   int(idOrName.IntVal),
+		Name: idOrName.StrVal,
+	}
+}
+
+// serverTypeUpToDate compares the Server type by ID or name, depending on
+// how the spec refers to it.
+func serverTypeUpToDate(desired intstr.IntOrString, actual *hcloud.ServerType) bool {
+	if actual == nil {
+		return true
+	}
+	if desired.Type == intstr.Int {
+		return int(desired.IntVal) == actual.ID
+	}
+	return desired.StrVal == actual.Name
+}
+
+// desiredRunning reports whether the Server should be running. PowerState
+// takes precedence over StartAfterCreate.
+func desiredRunning(sp v1alpha1.ServerParameters) bool {
+	if sp.PowerState != nil {
+		return *sp.PowerState == v1alpha1.PowerStateOn
+	}
+	return sp.StartAfterCreate == nil || *sp.StartAfterCreate
+}
+
+// powerUpToDate reports whether the Server is, or is about to be, in the
+// desired power state. Servers busy with anything else are left alone.
+func powerUpToDate(powerState *string, status hcloud.ServerStatus) bool {
+	if powerState == nil {
+		return true
+	}
+
+	switch status {
+	case hcloud.ServerStatusRunning, hcloud.ServerStatusStarting, hcloud.ServerStatusInitializing:
+		return *powerState == v1alpha1.PowerStateOn
+	case hcloud.ServerStatusOff, hcloud.ServerStatusStopping:
+		return *powerState == v1alpha1.PowerStateOff
+	default:
+		return true
+	}
+}
+
+func shutdownTimeout(sp v1alpha1.ServerParameters) time.Duration {
+	if sp.ShutdownTimeout == nil {
+		return defaultShutdownTimeout
+	}
+	return sp.ShutdownTimeout.Duration
+}
+
+// rebootPending reports whether RebootGeneration was increased since it was
+// last acted upon.
+func rebootPending(desired, observed *int64) bool {
+	return desired != nil && observed != nil && *desired > *observed
+}
+
+// desiredImage returns the ID or name of the Image, preferring the ID that is
+// set or resolved from a reference.
+func desiredImage(sp v1alpha1.ServerParameters) *string {
+	if sp.ImageID != nil {
+		return sp.ImageID
+	}
+	if sp.Image != nil {
+		return hcloud.Ptr(sp.Image.String())
+	}
+	return nil
+}
+
+// desiredSSHKeys returns the IDs and names of the SSHKeys, both set directly
+// and resolved from references.
+func desiredSSHKeys(sp v1alpha1.ServerParameters) []string {
+	keys := append([]string(nil), sp.SSHKeyIDs...)
+	if sp.SSHKeys != nil {
+		for _, key := range *sp.SSHKeys {
+			keys = append(keys, key.String())
 		}
-		opts.Firewalls = firewalls
 	}
+	return keys
+}
 
-	if sp.PlacementGroup != nil {
-		opts.PlacementGroup = &hcloud.PlacementGroup{
-			ID: *sp.PlacementGroup,
+// withDeprecatedIDs adds the IDs of a deprecated field to the ones of the
+// field that replaced it. The result is only nil if both are unset.
+func withDeprecatedIDs(ids []string, deprecated *[]int) []string {
+	if deprecated == nil {
+		return ids
+	}
+	merged := append(make([]string, 0, len(ids)+len(*deprecated)), ids...)
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+	for _, id := range *deprecated {
+		if s := strconv.Itoa(id); !seen[s] {
+			seen[s] = true
+			merged = append(merged, s)
 		}
 	}
+	return merged
+}
 
-	if sp.PublicNet != nil {
-		opts.PublicNet = &hcloud.ServerCreatePublicNet{
-			EnableIPv4: *sp.PublicNet.EnableIPv4,
-			EnableIPv6: *sp.PublicNet.EnableIPv6,
-			IPv4: &hcloud.PrimaryIP{
-				ID: *sp.PublicNet.IPv4,
-			},
-			IPv6: &hcloud.PrimaryIP{
-				ID: *sp.PublicNet.IPv6,
-			},
-		}
-	}
-	return opts
+// withDeprecatedID falls back to the ID of a deprecated field when the field
+// that replaced it is unset.
+func withDeprecatedID(id *string, deprecated *int) *string {
+	if id != nil || deprecated == nil {
+		return id
+	}
+	return hcloud.Ptr(strconv.Itoa(*deprecated))
+}
+
+// imageUpToDate compares the Image by ID or name, depending on how the spec
+// refers to it. Deleted Images cannot be compared and never drift.
+func imageUpToDate(desired *string, actual *hcloud.Image) bool {
+	if desired == nil || actual == nil {
+		return true
+	}
+	if id, err := strconv.Atoi(*desired); err == nil {
+		return id == actual.ID
+	}
+	return *desired == actual.Name
+}
+
+func imageName(img *hcloud.Image) string {
+	switch {
+	case img == nil:
+		return ""
+	case img.Name != "":
+		return img.Name
+	default:
+		return strconv.Itoa(img.ID)
+	}
+}
+
+// toSSHKey refers to the SSHKey by ID if the value is numeric and by name
+// otherwise.
+func toSSHKey(idOrName string) *hcloud.SSHKey {
+	if id, err := strconv.Atoi(idOrName); err == nil {
+		return &hcloud.SSHKey{ID: id}
+	}
+	return &hcloud.SSHKey{Name: idOrName}
+}
+
+// toIDs parses IDs that are either set directly or resolved from references.
+func toIDs(ids []string) ([]int, error) {
+	if ids == nil {
+		return nil, nil
+	}
+	parsed := make([]int, 0, len(ids))
+	for _, id := range ids {
+		v, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, v)
+	}
+	return parsed, nil
+}
+
+// toImage refers to the Image by ID if the value is numeric and by name
+// otherwise.
+func toImage(idOrName string) *hcloud.Image {
+	if id, err := strconv.Atoi(idOrName); err == nil {
+		return &hcloud.Image{ID: id}
+	}
+	return &hcloud.Image{Name: idOrName}
+}
+
+// rebuildOnImageChange reports whether the Server is rebuilt when its Image
+// changes.
+func rebuildOnImageChange(sp v1alpha1.ServerParameters) bool {
+	return sp.RebuildOnImageChange != nil && *sp.RebuildOnImageChange
+}
+
+// recreateOnUserDataChange reports whether the Server is recreated when its
+// user data changes.
+func recreateOnUserDataChange(sp v1alpha1.ServerParameters) bool {
+	return sp.RecreateOnUserDataChange != nil && *sp.RecreateOnUserDataChange
+}
+
+func optionalID(id int) *int {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func toISO(idOrName string) *hcloud.ISO {
+	if id, err := strconv.Atoi(idOrName); err == nil {
+		return &hcloud.ISO{ID: id}
+	}
+	return &hcloud.ISO{Name: idOrName}
+}
+
+// isoUpToDate reports whether the desired ISO is attached, or when none is
+// desired, whether no ISO that was attached for it is left over.
+func isoUpToDate(desired *string, attached string, iso *hcloud.ISO) bool {
+	if desired == nil {
+		return attached == ""
+	}
+	if iso == nil {
+		return false
+	}
+	if id, err := strconv.Atoi(*desired); err == nil {
+		return iso.ID == id
+	}
+	return iso.Name == *desired
+}
+
+func primaryIP(id *string) (*hcloud.PrimaryIP, error) {
+	if id == nil {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &hcloud.PrimaryIP{ID: v}, nil
+}
+
+// drift is the difference between the attachments of a Server and its spec.
+// Attachments the spec leaves unset are not managed and never drift.
+type drift struct {
+	placementGroup        *int
+	placementGroupChanged bool
+
+	applyFirewalls  []int
+	removeFirewalls []int
+
+	networks privateNetDiff
+
+	attachVolumes []int
+	detachVolumes []int
+}
+
+func (d drift) empty() bool {
+	return !d.placementGroupChanged &&
+		len(d.applyFirewalls) == 0 && len(d.removeFirewalls) == 0 &&
+		d.networks.empty() &&
+		len(d.attachVolumes) == 0 && len(d.detachVolumes) == 0
+}
+
+// diffServer returns how the attachments of the Server differ from its spec.
+// Only the Firewalls and Volumes the Server was attached to from its spec are
+// detached once they are not in the spec anymore.
+func diffServer(sp v1alpha1.ServerParameters, server *hcloud.Server, obs v1alpha1.ServerObservation) (drift, error) {
+	var d drift
+
+	if pg := withDeprecatedID(sp.PlacementGroupID, sp.PlacementGroup); pg != nil {
+		id, err := strconv.Atoi(*pg)
+		if err != nil {
+			return d, errors.Wrap(err, "invalid placementGroup")
+		}
+		d.placementGroup = &id
+		d.placementGroupChanged = server.PlacementGroup == nil || server.PlacementGroup.ID != id
+	}
+
+	firewalls, err := desiredFirewalls(sp)
+	if err != nil {
+		return d, err
+	}
+	d.applyFirewalls, d.removeFirewalls = diffIDs(firewalls, firewallIDs(server), obs.ManagedFirewalls)
+
+	networks, err := desiredPrivateNets(sp)
+	if err != nil {
+		return d, err
+	}
+	d.networks = diffPrivateNets(networks, server.PrivateNet)
+
+	volumes, err := desiredVolumes(sp)
+	if err != nil {
+		return d, err
+	}
+	d.attachVolumes, d.detachVolumes = diffIDs(volumes, volumeIDs(server), obs.ManagedVolumes)
+	return d, nil
+}
+
+func desiredFirewalls(sp v1alpha1.ServerParameters) ([]int, error) {
+	ids, err := toIDs(withDeprecatedIDs(sp.FirewallIDs, sp.Firewalls))
+	return ids, errors.Wrap(err, "invalid firewalls")
+}
+
+func desiredVolumes(sp v1alpha1.ServerParameters) ([]int, error) {
+	ids, err := toIDs(withDeprecatedIDs(sp.VolumeIDs, sp.Volumes))
+	return ids, errors.Wrap(err, "invalid volumes")
+}
+
+// trackAttachments records the Firewalls and Volumes the Server was attached
+// to from its spec. Servers attached before this was tracked are assumed to
+// be attached to the desired ones from their spec.
+func trackAttachments(sp v1alpha1.ServerParameters, server *hcloud.Server, obs *v1alpha1.ServerObservation) error {
+	if obs.ManagedFirewalls == nil {
+		firewalls, err := desiredFirewalls(sp)
+		if err != nil {
+			return err
+		}
+		obs.ManagedFirewalls = managedIDs(firewalls, firewallIDs(server))
+	}
+	if obs.ManagedVolumes == nil {
+		volumes, err := desiredVolumes(sp)
+		if err != nil {
+			return err
+		}
+		obs.ManagedVolumes = managedIDs(volumes, volumeIDs(server))
+	}
+	return nil
+}
+
+// diffIDs returns the IDs that are desired but missing and the managed ones
+// that are present but not desired anymore.
+func diffIDs(desired, actual, managed []int) (add, remove []int) {
+	have := make(map[int]bool, len(actual))
+	for _, id := range actual {
+		have[id] = true
+	}
+	want := make(map[int]bool, len(desired))
+	for _, id := range desired {
+		want[id] = true
+		if !have[id] {
+			add = append(add, id)
+		}
+	}
+	for _, id := range managed {
+		if have[id] && !want[id] {
+			remove = append(remove, id)
+		}
+	}
+	return add, remove
+}
+
+// managedIDs returns the desired IDs that are present.
+func managedIDs(desired, actual []int) []int {
+	have := make(map[int]bool, len(actual))
+	for _, id := range actual {
+		have[id] = true
+	}
+	var managed []int
+	for _, id := range desired {
+		if have[id] {
+			managed = append(managed, id)
+		}
+	}
+	return managed
+}
+
+func firewallIDs(server *hcloud.Server) []int {
+	ids := make([]int, 0, len(server.PublicNet.Firewalls))
+	for _, fw := range server.PublicNet.Firewalls {
+		ids = append(ids, fw.Firewall.ID)
+	}
+	return ids
+}
+
+func volumeIDs(server *hcloud.Server) []int {
+	ids := make([]int, 0, len(server.Volumes))
+	for _, vol := range server.Volumes {
+		ids = append(ids, vol.ID)
+	}
+	return ids
+}
+
+// privateNet is a desired attachment of a Server to a Network. The IP and
+// alias IPs of attachments listed in Networks are left to Hetzner.
+type privateNet struct {
+	network  int
+	ip       net.IP
+	aliasIPs []net.IP
+	fixed    bool
+}
+
+func desiredPrivateNets(sp v1alpha1.ServerParameters) (map[int]privateNet, error) {
+	networks := withDeprecatedIDs(sp.NetworkIDs, sp.Networks)
+	if networks == nil && sp.PrivateNetworks == nil {
+		return nil, nil
+	}
+
+	ids, err := toIDs(networks)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid networks")
+	}
+
+	desired := make(map[int]privateNet, len(ids)+len(sp.PrivateNetworks))
+	for _, id := range ids {
+		desired[id] = privateNet{network: id}
+	}
+
+	for _, pn := range sp.PrivateNetworks {
+		if pn.Network == nil {
+			return nil, errors.New("private network is neither set nor resolved from a reference")
+		}
+		id, err := strconv.Atoi(*pn.Network)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid private network")
+		}
+
+		n := privateNet{network: id, fixed: true}
+		if pn.IP != nil {
+			if n.ip = net.ParseIP(*pn.IP); n.ip == nil {
+				return nil, errors.Errorf("invalid private network IP %q", *pn.IP)
+			}
+		}
+		for _, alias := range pn.AliasIPs {
+			ip := net.ParseIP(alias)
+			if ip == nil {
+				return nil, errors.Errorf("invalid private network alias IP %q", alias)
+			}
+			n.aliasIPs = append(n.aliasIPs, ip)
+		}
+		desired[id] = n
+	}
+	return desired, nil
+}
+
+// privateNetDiff lists the Networks to detach from, the ones to attach to and
+// the ones whose alias IPs need to change. A changed static IP requires the
+// Server to be detached and attached again.
+type privateNetDiff struct {
+	detach  []int
+	attach  []privateNet
+	aliases []privateNet
+}
+
+func (d privateNetDiff) empty() bool {
+	return len(d.detach) == 0 && len(d.attach) == 0 && len(d.aliases) == 0
+}
+
+func diffPrivateNets(desired map[int]privateNet, actual []hcloud.ServerPrivateNet) privateNetDiff {
+	var d privateNetDiff
+	if desired == nil {
+		return d
+	}
+
+	attached := make(map[int]bool, len(actual))
+	for _, pn := range actual {
+		id := pn.Network.ID
+		attached[id] = true
+
+		want, ok := desired[id]
+		switch {
+		case !ok:
+			d.detach = append(d.detach, id)
+		case want.ip != nil && !want.ip.Equal(pn.IP):
+			d.detach = append(d.detach, id)
+			d.attach = append(d.attach, want)
+		case want.fixed && !sameIPs(want.aliasIPs, pn.Aliases):
+			d.aliases = append(d.aliases, want)
+		}
+	}
+
+	ids := make([]int, 0, len(desired))
+	for id := range desired {
+		if !attached[id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		d.attach = append(d.attach, desired[id])
+	}
+	return d
+}
+
+func sameIPs(a, b []net.IP) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]bool, len(a))
+	for _, ip := range a {
+		set[ip.String()] = true
+	}
+	for _, ip := range b {
+		if !set[ip.String()] {
+			return false
+		}
+	}
+	return true
+}
+
+func toPrivateNetworkObservations(nets []hcloud.ServerPrivateNet) []v1alpha1.ServerPrivateNetworkObservation {
+	if len(nets) == 0 {
+		return nil
+	}
+
+	obs := make([]v1alpha1.ServerPrivateNetworkObservation, 0, len(nets))
+	for _, pn := range nets {
+		o := v1alpha1.ServerPrivateNetworkObservation{
+			Network: pn.Network.ID,
+			IP:      pn.IP.String(),
+		}
+		for _, alias := range pn.Aliases {
+			o.AliasIPs = append(o.AliasIPs, alias.String())
+		}
+		obs = append(obs, o)
+	}
+	return obs
+}
+
+// The root password set by a rebuild is not returned by hcloud-go yet, so the
+// rebuild action is run through a plain API request.
+type serverRebuildResponse struct {
+	Action       schema.Action `json:"action"`
+	RootPassword *string       `json:"root_password"`
+}
+
+func (s *HCloudService) rebuild(ctx context.Context, server *hcloud.Server, image *hcloud.Image) (*hcloud.Action, string, error) {
+	reqBody := map[string]interface{}{"image": image.Name}
+	if image.ID != 0 {
+		reqBody["image"] = image.ID
+	}
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, "", err
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", fmt.Sprintf("/servers/%d/actions/rebuild", server.ID), bytes.NewReader(body))
+	if err != nil {
+		return nil, "", err
+	}
+
+	var respBody serverRebuildResponse
+	if _, err := s.client.Do(req, &respBody); err != nil {
+		return nil, "", err
+	}
+
+	var rootPassword string
+	if respBody.RootPassword != nil {
+		rootPassword = *respBody.RootPassword
+	}
+	return hcloud.ActionFromSchema(respBody.Action), rootPassword, nil
+}
+
+// backupsUpToDate reports whether backups of a Server are enabled, as
+// indicated by its backup window, if the desired state is known.
+func backupsUpToDate(desired *bool, window string) bool {
+	return desired == nil || *desired == (window != "")
+}
+
+func toBackupObservations(images []*hcloud.Image) []v1alpha1.ServerBackupObservation {
+	if len(images) == 0 {
+		return nil
+	}
+	backups := make([]v1alpha1.ServerBackupObservation, 0, len(images))
+	for _, img := range images {
+		backups = append(backups, v1alpha1.ServerBackupObservation{
+			ID:          img.ID,
+			Description: img.Description,
+			Created:     &metav1.Time{Time: img.Created},
+		})
+	}
+	return backups
+}
+
+// dnsPtrChanges returns the reverse DNS entries of the public IPs of the
+// Server that have to be set, IPv6 entries anywhere in its assigned network.
+func dnsPtrChanges(sp v1alpha1.ServerParameters, server *hcloud.Server) ([]util.DNSPtrChange, error) {
+	return util.DNSPtrChanges(sp.RDNS, server,
+		util.HostNet(server.PublicNet.IPv4.IP), server.PublicNet.IPv6.Network)
+}
+
+// maxUserDataSize is the maximum size of user data Hetzner accepts.
+const maxUserDataSize = 32 * 1024
+
+// userDataValues are the values user data templates are rendered with.
+type userDataValues struct {
+	Name            string
+	Labels          map[string]string
+	Location        string
+	Datacenter      string
+	PrivateNetworks []userDataNetwork
+	SSHKeys         []userDataSSHKey
+}
+
+type userDataNetwork struct {
+	Network int
+	IP      string
+}
+
+type userDataSSHKey struct {
+	ID          int
+	Name        string
+	Fingerprint string
+	PublicKey   string
+}
+
+// toUserDataValues returns the values of the Server known before it is
+// created. SSH keys are looked up separately.
+func toUserDataValues(name string, sp v1alpha1.ServerParameters) (userDataValues, error) {
+	values := userDataValues{Name: name, Labels: sp.Labels}
+	if sp.Location != nil {
+		values.Location = sp.Location.String()
+	}
+	if sp.Datacenter != nil {
+		values.Datacenter = sp.Datacenter.String()
+	}
+
+	nets, err := desiredPrivateNets(sp)
+	if err != nil {
+		return values, err
+	}
+	for id, pn := range nets {
+		n := userDataNetwork{Network: id}
+		if pn.ip != nil {
+			n.IP = pn.ip.String()
+		}
+		values.PrivateNetworks = append(values.PrivateNetworks, n)
+	}
+	sort.Slice(values.PrivateNetworks, func(i, j int) bool {
+		return values.PrivateNetworks[i].Network < values.PrivateNetworks[j].Network
+	})
+	return values, nil
+}
+
+func toUserDataSSHKey(key *v1alpha1.SSHKey) userDataSSHKey {
+	return userDataSSHKey{
+		ID:          key.Status.AtProvider.Id,
+		Name:        meta.GetExternalName(key),
+		Fingerprint: key.Status.AtProvider.Fingerprint,
+		PublicKey:   key.Status.AtProvider.PublicKey,
+	}
+}
+
+// renderUserData renders user data as a Go template. Referring to values
+// that do not exist is an error rather than rendering "<no value>".
+func renderUserData(text string, values userDataValues) (string, error) {
+	tmpl, err := template.New("userData").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot parse user data template")
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, values); err != nil {
+		return "", errors.Wrap(err, "cannot render user data template")
+	}
+	return buf.String(), nil
+}
+
+// encodeUserData encodes user data and checks that the result is within
+// the size Hetzner accepts.
+func encodeUserData(data, encoding string) (string, error) {
+	if encoding == v1alpha1.UserDataEncodingGzipBase64 {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write([]byte(data)); err != nil {
+			return "", err
+		}
+		if err := zw.Close(); err != nil {
+			return "", err
+		}
+		data = base64.StdEncoding.EncodeToString(buf.Bytes())
+	}
+
+	if len(data) > maxUserDataSize {
+		return "", errors.Errorf("user data is %d bytes, more than the %d bytes Hetzner accepts", len(data), maxUserDataSize)
+	}
+	return data, nil
 }
@@ -0,0 +1,652 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestToServerCreateOpts(t *testing.T) {
+	image := intstr.FromString("ubuntu-22.04")
+	snapshot := "1234"
+	placementGroup := "7"
+	invalid := "my-volume"
+
+	cases := map[string]struct {
+		reason  string
+		params  v1alpha1.ServerParameters
+		want    hcloud.ServerCreateOpts
+		wantErr bool
+	}{
+		"ResolvedReferences": {
+			reason: "IDs resolved from references should be passed on as IDs, while SSHKeys may also be names.",
+			params: v1alpha1.ServerParameters{
+				ServerType:       intstr.FromString("cx11"),
+				ImageID:          &snapshot,
+				SSHKeyIDs:        []string{"3"},
+				SSHKeys:          &[]intstr.IntOrString{intstr.FromString("my-key")},
+				VolumeIDs:        []string{"4"},
+				NetworkIDs:       []string{"5"},
+				FirewallIDs:      []string{"6"},
+				PlacementGroupID: &placementGroup,
+			},
+			want: hcloud.ServerCreateOpts{
+				Name:             "srv",
+				ServerType:       &hcloud.ServerType{Name: "cx11"},
+				Image:            &hcloud.Image{ID: 1234},
+				StartAfterCreate: hcloud.Ptr(true),
+				SSHKeys:          []*hcloud.SSHKey{{ID: 3}, {Name: "my-key"}},
+				Volumes:          []*hcloud.Volume{{ID: 4}},
+				Networks:         []*hcloud.Network{{ID: 5}},
+				Firewalls:        []*hcloud.ServerCreateFirewall{{Firewall: hcloud.Firewall{ID: 6}}},
+				PlacementGroup:   &hcloud.PlacementGroup{ID: 7},
+			},
+		},
+		"ImageByName": {
+			reason: "A non-numeric Image should be referred to by name.",
+			params: v1alpha1.ServerParameters{
+				ServerType: intstr.FromString("cx11"),
+				Image:      &image,
+			},
+			want: hcloud.ServerCreateOpts{
+				Name:             "srv",
+				ServerType:       &hcloud.ServerType{Name: "cx11"},
+				Image:            &hcloud.Image{Name: "ubuntu-22.04"},
+				StartAfterCreate: hcloud.Ptr(true),
+			},
+		},
+		"Deprecated": {
+			reason: "The deprecated fields should still be honoured next to the ones that replaced them.",
+			params: v1alpha1.ServerParameters{
+				ServerType:     intstr.FromString("cx11"),
+				Image:          hcloud.Ptr(intstr.FromInt(1234)),
+				SSHKeys:        &[]intstr.IntOrString{intstr.FromInt(3)},
+				VolumeIDs:      []string{"4"},
+				Volumes:        &[]int{4, 8},
+				Networks:       &[]int{5},
+				Firewalls:      &[]int{6},
+				PlacementGroup: hcloud.Ptr(7),
+				PublicNet:      &v1alpha1.PublicNetwork{IPv4: hcloud.Ptr(10)},
+			},
+			want: hcloud.ServerCreateOpts{
+				Name:             "srv",
+				ServerType:       &hcloud.ServerType{Name: "cx11"},
+				Image:            &hcloud.Image{ID: 1234},
+				StartAfterCreate: hcloud.Ptr(true),
+				SSHKeys:          []*hcloud.SSHKey{{ID: 3}},
+				Volumes:          []*hcloud.Volume{{ID: 4}, {ID: 8}},
+				Networks:         []*hcloud.Network{{ID: 5}},
+				Firewalls:        []*hcloud.ServerCreateFirewall{{Firewall: hcloud.Firewall{ID: 6}}},
+				PlacementGroup:   &hcloud.PlacementGroup{ID: 7},
+				PublicNet: &hcloud.ServerCreatePublicNet{
+					EnableIPv4: true,
+					EnableIPv6: true,
+					IPv4:       &hcloud.PrimaryIP{ID: 10},
+				},
+			},
+		},
+		"PowerStateOff": {
+			reason: "A Server that should be off should not be started after creation.",
+			params: v1alpha1.ServerParameters{
+				ServerType:       intstr.FromString("cx11"),
+				Image:            &image,
+				StartAfterCreate: hcloud.Ptr(true),
+				PowerState:       hcloud.Ptr(v1alpha1.PowerStateOff),
+			},
+			want: hcloud.ServerCreateOpts{
+				Name:             "srv",
+				ServerType:       &hcloud.ServerType{Name: "cx11"},
+				Image:            &hcloud.Image{Name: "ubuntu-22.04"},
+				StartAfterCreate: hcloud.Ptr(false),
+			},
+		},
+		"UnresolvedImage": {
+			reason: "A Server cannot be created before its Image is known.",
+			params: v1alpha1.ServerParameters{
+				ServerType: intstr.FromString("cx11"),
+			},
+			wantErr: true,
+		},
+		"InvalidVolume": {
+			reason: "Volumes can only be referred to by ID.",
+			params: v1alpha1.ServerParameters{
+				ServerType: intstr.FromString("cx11"),
+				Image:      &image,
+				VolumeIDs:  []string{invalid},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := toServerCreateOpts("srv", tc.params)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\ntoServerCreateOpts(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntoServerCreateOpts(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDiffServer(t *testing.T) {
+	placementGroup := "7"
+	network := "5"
+	ip := "10.0.0.5"
+
+	server := &hcloud.Server{
+		PublicNet: hcloud.ServerPublicNet{
+			Firewalls: []*hcloud.ServerFirewallStatus{
+				{Firewall: hcloud.Firewall{ID: 1}},
+				{Firewall: hcloud.Firewall{ID: 2}},
+			},
+		},
+		PrivateNet: []hcloud.ServerPrivateNet{
+			{Network: &hcloud.Network{ID: 4}, IP: net.ParseIP("10.1.0.2")},
+			{Network: &hcloud.Network{ID: 5}, IP: net.ParseIP("10.0.0.2")},
+			{Network: &hcloud.Network{ID: 6}, IP: net.ParseIP("10.2.0.2"), Aliases: []net.IP{net.ParseIP("10.2.0.3")}},
+		},
+		PlacementGroup: &hcloud.PlacementGroup{ID: 8},
+		Volumes:        []*hcloud.Volume{{ID: 9}},
+	}
+
+	cases := map[string]struct {
+		reason  string
+		params  v1alpha1.ServerParameters
+		managed v1alpha1.ServerObservation
+		want    drift
+	}{
+		"Unmanaged": {
+			reason: "Attachments the spec leaves unset should never drift.",
+			params: v1alpha1.ServerParameters{},
+			want:   drift{},
+		},
+		"UpToDate": {
+			reason: "Attachments matching the spec should not drift.",
+			params: v1alpha1.ServerParameters{
+				FirewallIDs: []string{"2", "1"},
+				NetworkIDs:  []string{"4", "5", "6"},
+				VolumeIDs:   []string{"9"},
+			},
+			want: drift{},
+		},
+		"Deprecated": {
+			reason: "The deprecated fields should manage attachments just like the ones that replaced them.",
+			params: v1alpha1.ServerParameters{
+				PlacementGroup: hcloud.Ptr(8),
+				Firewalls:      &[]int{2, 1},
+				Networks:       &[]int{4, 5, 6},
+				Volumes:        &[]int{},
+			},
+			managed: v1alpha1.ServerObservation{ManagedVolumes: []int{9}},
+			want: drift{
+				placementGroup: hcloud.Ptr(8),
+				detachVolumes:  []int{9},
+			},
+		},
+		"Drifted": {
+			reason: "Every attachment that differs from the spec should be changed.",
+			params: v1alpha1.ServerParameters{
+				PlacementGroupID: &placementGroup,
+				FirewallIDs:      []string{"2", "3"},
+				NetworkIDs:       []string{"6", "10"},
+				PrivateNetworks: []v1alpha1.ServerPrivateNetwork{
+					{Network: &network, IP: &ip},
+				},
+				VolumeIDs: []string{},
+			},
+			managed: v1alpha1.ServerObservation{ManagedFirewalls: []int{1, 2}, ManagedVolumes: []int{9}},
+			want: drift{
+				placementGroup:        hcloud.Ptr(7),
+				placementGroupChanged: true,
+				applyFirewalls:        []int{3},
+				removeFirewalls:       []int{1},
+				networks: privateNetDiff{
+					detach: []int{4, 5},
+					attach: []privateNet{
+						{network: 5, ip: net.ParseIP(ip), fixed: true},
+						{network: 10},
+					},
+				},
+				detachVolumes: []int{9},
+			},
+		},
+		"Unattached": {
+			reason: "Firewalls and Volumes that were not attached from the spec should never be detached.",
+			params: v1alpha1.ServerParameters{
+				FirewallIDs: []string{"3"},
+				VolumeIDs:   []string{},
+			},
+			managed: v1alpha1.ServerObservation{ManagedFirewalls: []int{2}},
+			want: drift{
+				applyFirewalls:  []int{3},
+				removeFirewalls: []int{2},
+			},
+		},
+		"AliasIPs": {
+			reason: "Alias IPs should only be managed for private networks.",
+			params: v1alpha1.ServerParameters{
+				NetworkIDs: []string{"4", "5"},
+				PrivateNetworks: []v1alpha1.ServerPrivateNetwork{
+					{Network: hcloud.Ptr("6")},
+				},
+			},
+			want: drift{
+				networks: privateNetDiff{
+					aliases: []privateNet{{network: 6, fixed: true}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := diffServer(tc.params, server, tc.managed)
+			if err != nil {
+				t.Fatalf("\n%s\ndiffServer(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(drift{}, privateNetDiff{}, privateNet{})); diff != "" {
+				t.Errorf("\n%s\ndiffServer(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTrackAttachments(t *testing.T) {
+	server := &hcloud.Server{
+		PublicNet: hcloud.ServerPublicNet{
+			Firewalls: []*hcloud.ServerFirewallStatus{
+				{Firewall: hcloud.Firewall{ID: 1}},
+				{Firewall: hcloud.Firewall{ID: 2}},
+			},
+		},
+		Volumes: []*hcloud.Volume{{ID: 9}},
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.ServerParameters
+		obs    v1alpha1.ServerObservation
+		want   v1alpha1.ServerObservation
+	}{
+		"Untracked": {
+			reason: "The desired attachments that exist should be assumed to be attached from the spec.",
+			params: v1alpha1.ServerParameters{
+				FirewallIDs: []string{"2", "3"},
+				VolumeIDs:   []string{"9"},
+			},
+			want: v1alpha1.ServerObservation{ManagedFirewalls: []int{2}, ManagedVolumes: []int{9}},
+		},
+		"Tracked": {
+			reason: "Tracked attachments should be kept.",
+			params: v1alpha1.ServerParameters{
+				FirewallIDs: []string{"2"},
+			},
+			obs:  v1alpha1.ServerObservation{ManagedFirewalls: []int{1}},
+			want: v1alpha1.ServerObservation{ManagedFirewalls: []int{1}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := trackAttachments(tc.params, server, &tc.obs); err != nil {
+				t.Fatalf("\n%s\ntrackAttachments(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, tc.obs); diff != "" {
+				t.Errorf("\n%s\ntrackAttachments(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestServerTypeUpToDate(t *testing.T) {
+	actual := &hcloud.ServerType{ID: 1, Name: "cx11"}
+
+	cases := map[string]struct {
+		desired intstr.IntOrString
+		want    bool
+	}{
+		"SameName":      {desired: intstr.FromString("cx11"), want: true},
+		"DifferentName": {desired: intstr.FromString("cx21"), want: false},
+		"SameID":        {desired: intstr.FromInt(1), want: true},
+		"DifferentID":   {desired: intstr.FromInt(3), want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := serverTypeUpToDate(tc.desired, actual); got != tc.want {
+				t.Errorf("serverTypeUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestImageUpToDate(t *testing.T) {
+	system := &hcloud.Image{ID: 1, Name: "ubuntu-22.04"}
+	snapshot := &hcloud.Image{ID: 1234}
+
+	cases := map[string]struct {
+		desired *string
+		actual  *hcloud.Image
+		want    bool
+	}{
+		"SameName":      {desired: hcloud.Ptr("ubuntu-22.04"), actual: system, want: true},
+		"DifferentName": {desired: hcloud.Ptr("debian-12"), actual: system, want: false},
+		"SameID":        {desired: hcloud.Ptr("1234"), actual: snapshot, want: true},
+		"DifferentID":   {desired: hcloud.Ptr("4321"), actual: snapshot, want: false},
+		"DeletedImage":  {desired: hcloud.Ptr("debian-12"), actual: nil, want: true},
+		"Unresolved":    {desired: nil, actual: system, want: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := imageUpToDate(tc.desired, tc.actual); got != tc.want {
+				t.Errorf("imageUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPowerUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		powerState *string
+		status     hcloud.ServerStatus
+		want       bool
+	}{
+		"Unmanaged":  {powerState: nil, status: hcloud.ServerStatusOff, want: true},
+		"On":         {powerState: hcloud.Ptr(v1alpha1.PowerStateOn), status: hcloud.ServerStatusRunning, want: true},
+		"Starting":   {powerState: hcloud.Ptr(v1alpha1.PowerStateOn), status: hcloud.ServerStatusStarting, want: true},
+		"NotOn":      {powerState: hcloud.Ptr(v1alpha1.PowerStateOn), status: hcloud.ServerStatusOff, want: false},
+		"Off":        {powerState: hcloud.Ptr(v1alpha1.PowerStateOff), status: hcloud.ServerStatusOff, want: true},
+		"Stopping":   {powerState: hcloud.Ptr(v1alpha1.PowerStateOff), status: hcloud.ServerStatusStopping, want: true},
+		"NotOff":     {powerState: hcloud.Ptr(v1alpha1.PowerStateOff), status: hcloud.ServerStatusRunning, want: false},
+		"Rebuilding": {powerState: hcloud.Ptr(v1alpha1.PowerStateOff), status: hcloud.ServerStatusRebuilding, want: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := powerUpToDate(tc.powerState, tc.status); got != tc.want {
+				t.Errorf("powerUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRebootPending(t *testing.T) {
+	cases := map[string]struct {
+		desired  *int64
+		observed *int64
+		want     bool
+	}{
+		"Unset":      {desired: nil, observed: hcloud.Ptr(int64(0)), want: false},
+		"Unobserved": {desired: hcloud.Ptr(int64(1)), observed: nil, want: false},
+		"Same":       {desired: hcloud.Ptr(int64(1)), observed: hcloud.Ptr(int64(1)), want: false},
+		"Increased":  {desired: hcloud.Ptr(int64(2)), observed: hcloud.Ptr(int64(1)), want: true},
+		"Decreased":  {desired: hcloud.Ptr(int64(0)), observed: hcloud.Ptr(int64(1)), want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := rebootPending(tc.desired, tc.observed); got != tc.want {
+				t.Errorf("rebootPending(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBackupsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired *bool
+		window  string
+		want    bool
+	}{
+		"Unmanaged":      {desired: nil, window: "22-02", want: true},
+		"Enabled":        {desired: hcloud.Ptr(true), window: "22-02", want: true},
+		"EnableDisabled": {desired: hcloud.Ptr(true), window: "", want: false},
+		"Disabled":       {desired: hcloud.Ptr(false), window: "", want: true},
+		"DisableEnabled": {desired: hcloud.Ptr(false), window: "22-02", want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := backupsUpToDate(tc.desired, tc.window); got != tc.want {
+				t.Errorf("backupsUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDNSPtrChanges(t *testing.T) {
+	_, ipv6Net, _ := net.ParseCIDR("2001:db8:1::/64")
+	server := &hcloud.Server{
+		PublicNet: hcloud.ServerPublicNet{
+			IPv4: hcloud.ServerPublicNetIPv4{IP: net.ParseIP("203.0.113.1"), DNSPtr: "static.example.com"},
+			IPv6: hcloud.ServerPublicNetIPv6{
+				IP:      ipv6Net.IP,
+				Network: ipv6Net,
+				DNSPtr:  map[string]string{"2001:db8:1::1": "mail.example.com"},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		reason  string
+		rdns    []v1alpha1.ReverseDNS
+		want    []string
+		wantErr bool
+	}{
+		"Unmanaged": {
+			reason: "No changes are needed without desired entries.",
+		},
+		"UpToDate": {
+			reason: "Entries matching the actual ones are not changed.",
+			rdns: []v1alpha1.ReverseDNS{
+				{IP: "203.0.113.1", Hostname: "static.example.com"},
+				{IP: "2001:db8:1:0::1", Hostname: "mail.example.com"},
+			},
+		},
+		"Changed": {
+			reason: "Differing entries and entries within the IPv6 network are changed.",
+			rdns: []v1alpha1.ReverseDNS{
+				{IP: "203.0.113.1", Hostname: "mail.example.com"},
+				{IP: "2001:db8:1::2", Hostname: "mx.example.com"},
+			},
+			want: []string{"203.0.113.1=mail.example.com", "2001:db8:1::2=mx.example.com"},
+		},
+		"Foreign": {
+			reason:  "Entries for addresses not assigned to the Server are rejected.",
+			rdns:    []v1alpha1.ReverseDNS{{IP: "2001:db8:2::1", Hostname: "mail.example.com"}},
+			wantErr: true,
+		},
+		"Invalid": {
+			reason:  "Entries with invalid addresses are rejected.",
+			rdns:    []v1alpha1.ReverseDNS{{IP: "mail", Hostname: "mail.example.com"}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changes, err := dnsPtrChanges(v1alpha1.ServerParameters{RDNS: tc.rdns}, server)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\ndnsPtrChanges(...): want error %t, got %v\n", tc.reason, tc.wantErr, err)
+			}
+			var got []string
+			for _, c := range changes {
+				got = append(got, c.IP.String()+"="+c.Hostname)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ndnsPtrChanges(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestISOUpToDate(t *testing.T) {
+	iso := &hcloud.ISO{ID: 42, Name: "virtio-win-0.1.185.iso"}
+
+	cases := map[string]struct {
+		desired  *string
+		attached string
+		iso      *hcloud.ISO
+		want     bool
+	}{
+		"Unmanaged":      {desired: nil, attached: "", iso: iso, want: true},
+		"LeftOver":       {desired: nil, attached: "42", iso: iso, want: false},
+		"AttachedByID":   {desired: hcloud.Ptr("42"), attached: "42", iso: iso, want: true},
+		"AttachedByName": {desired: hcloud.Ptr("virtio-win-0.1.185.iso"), iso: iso, want: true},
+		"Detached":       {desired: hcloud.Ptr("42"), attached: "42", iso: nil, want: false},
+		"OtherAttached":  {desired: hcloud.Ptr("43"), attached: "42", iso: iso, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := isoUpToDate(tc.desired, tc.attached, tc.iso); got != tc.want {
+				t.Errorf("isoUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRenderUserData(t *testing.T) {
+	values := userDataValues{
+		Name:            "web-1",
+		PrivateNetworks: []userDataNetwork{{Network: 4, IP: "10.0.1.5"}},
+		SSHKeys:         []userDataSSHKey{{ID: 1, Name: "ops", Fingerprint: "b7:2f:30"}},
+	}
+
+	cases := map[string]struct {
+		reason  string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		"Plain": {
+			reason: "User data without actions is rendered as is.",
+			text:   "#cloud-config\n",
+			want:   "#cloud-config\n",
+		},
+		"Values": {
+			reason: "Server values are rendered into the user data.",
+			text:   "{{ .Name }} {{ (index .PrivateNetworks 0).IP }} {{ range .SSHKeys }}{{ .Fingerprint }}{{ end }}",
+			want:   "web-1 10.0.1.5 b7:2f:30",
+		},
+		"Unknown": {
+			reason:  "Referring to unknown values is an error.",
+			text:    "{{ .Hostname }}",
+			wantErr: true,
+		},
+		"Invalid": {
+			reason:  "Invalid templates are an error.",
+			text:    "{{ .Name ",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := renderUserData(tc.text, values)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\nrenderUserData(...): want error %t, got %v\n", tc.reason, tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nrenderUserData(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestToUserDataSSHKey(t *testing.T) {
+	key := &v1alpha1.SSHKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "ops-key"},
+		Status: v1alpha1.SSHKeyStatus{
+			AtProvider: v1alpha1.SSHKeyObservation{Id: 1, Fingerprint: "b7:2f:30", PublicKey: "ssh-ed25519 AAAA"},
+		},
+	}
+	meta.SetExternalName(key, "ops")
+
+	want := userDataSSHKey{ID: 1, Name: "ops", Fingerprint: "b7:2f:30", PublicKey: "ssh-ed25519 AAAA"}
+	if diff := cmp.Diff(want, toUserDataSSHKey(key)); diff != "" {
+		t.Errorf("\nReferenced SSHKeys should be taken from their status and named by their external name.\ntoUserDataSSHKey(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestEncodeUserData(t *testing.T) {
+	large := strings.Repeat("a", maxUserDataSize+1)
+
+	cases := map[string]struct {
+		reason   string
+		data     string
+		encoding string
+		wantErr  bool
+	}{
+		"None": {
+			reason:   "User data within the limit is sent as is.",
+			data:     "#cloud-config\n",
+			encoding: v1alpha1.UserDataEncodingNone,
+		},
+		"TooLarge": {
+			reason:   "User data beyond the limit is rejected.",
+			data:     large,
+			encoding: v1alpha1.UserDataEncodingNone,
+			wantErr:  true,
+		},
+		"Compressed": {
+			reason:   "Compressed user data only has to fit the limit once compressed.",
+			data:     large,
+			encoding: v1alpha1.UserDataEncodingGzipBase64,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := encodeUserData(tc.data, tc.encoding)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\nencodeUserData(...): want error %t, got %v\n", tc.reason, tc.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if tc.encoding == v1alpha1.UserDataEncodingGzipBase64 {
+				got = gunzip(t, got)
+			}
+			if got != tc.data {
+				t.Errorf("\n%s\nencodeUserData(...): decoded user data differs from the original\n", tc.reason)
+			}
+		})
+	}
+}
+
+func gunzip(t *testing.T, encoded string) string {
+	t.Helper()
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -18,6 +18,7 @@ package sshkey
 
 import (
 	"context"
+	"fmt"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/pkg/errors"
@@ -48,8 +49,16 @@ const (
 	errGetCreds     = "cannot get credentials"
 
 	errNewClient = "cannot create new Service"
+
+	errGenerate    = "cannot generate SSH keypair"
+	errFingerprint = "cannot compute fingerprint of the public key"
+	errRotate      = "cannot rotate SSHKey"
+	errDelReplaced = "cannot delete replaced SSHKey"
 )
 
+// reasonRotate is the reason of events about rotated SSHKeys.
+const reasonRotate event.Reason = "RotateSSHKey"
+
 // A HCloudService is the interface to the Hetzner cloud API.
 type HCloudService struct {
 	client *hcloud.Client
@@ -72,15 +81,17 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.SSHKeyGroupVersionKind),
 		managed.WithExternalConnecter(&connector{
 			kube:         mgr.GetClient(),
 			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:     recorder,
 			newServiceFn: hCloudService}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -95,6 +106,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube         client.Client
 	usage        resource.Tracker
+	recorder     event.Recorder
 	newServiceFn func(creds []byte) (*HCloudService, error)
 }
 
@@ -129,7 +141,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{service: svc}, nil
+	return &external{service: svc, recorder: c.recorder}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -137,7 +149,8 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
-	service *HCloudService
+	service  *HCloudService
+	recorder event.Recorder
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -152,19 +165,32 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	exists := key != nil && key.ID > 0
-	if exists {
-		cr.Status.SetConditions(xpv1.Available())
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
 
-		cr.Status.AtProvider.Id = key.ID
-		cr.Status.AtProvider.Created = &metav1.Time{Time: key.Created}
-		cr.Status.AtProvider.Fingerprint = key.Fingerprint
+	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.Id = key.ID
+	cr.Status.AtProvider.Created = &metav1.Time{Time: key.Created}
+	cr.Status.AtProvider.Fingerprint = key.Fingerprint
+	cr.Status.AtProvider.PublicKey = key.PublicKey
+
+	keyUpToDate, err := publicKeyUpToDate(cr.Spec.ForProvider.PublicKey, key.Fingerprint)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFingerprint)
 	}
 
+	// The private key of a generated keypair is only known when it is
+	// created, and is kept in the connection secret from then on.
 	return managed.ExternalObservation{
-		ResourceExists:    exists,
-		ResourceUpToDate:  exists && util.LabelsUpToDate(cr.Spec.ForProvider.Labels, key.Labels),
-		ConnectionDetails: managed.ConnectionDetails{},
-	}, err
+		ResourceExists: true,
+		ResourceUpToDate: keyUpToDate && cr.Status.AtProvider.ReplacedID == 0 &&
+			util.LabelsUpToDate(cr.Spec.ForProvider.Labels, key.Labels),
+		ConnectionDetails: managed.ConnectionDetails{
+			"publicKey":   []byte(key.PublicKey),
+			"fingerprint": []byte(key.Fingerprint),
+		},
+	}, nil
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -173,15 +199,29 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotSSHKey)
 	}
 
-	_, _, err := c.service.client.SSHKey.Create(ctx, hcloud.SSHKeyCreateOpts{
+	publicKey := cr.Spec.ForProvider.PublicKey
+	connectionDetails := managed.ConnectionDetails{}
+	if publicKey == "" {
+		pair, err := generateKeyPair(cr.Spec.ForProvider.KeyType)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGenerate)
+		}
+		publicKey = string(pair.publicKey)
+		connectionDetails["privateKey"] = pair.privateKey
+	}
+
+	key, _, err := c.service.client.SSHKey.Create(ctx, hcloud.SSHKeyCreateOpts{
 		Name:      meta.GetExternalName(cr),
-		PublicKey: cr.Spec.ForProvider.PublicKey,
+		PublicKey: publicKey,
 		Labels:    cr.Spec.ForProvider.Labels,
 	})
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
 
-	return managed.ExternalCreation{
-		ConnectionDetails: managed.ConnectionDetails{},
-	}, err
+	connectionDetails["publicKey"] = []byte(key.PublicKey)
+	connectionDetails["fingerprint"] = []byte(key.Fingerprint)
+	return managed.ExternalCreation{ConnectionDetails: connectionDetails}, nil
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -190,7 +230,19 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotSSHKey)
 	}
 
-	_, _, err := c.service.client.SSHKey.Update(ctx, &hcloud.SSHKey{ID: cr.Status.AtProvider.Id}, hcloud.SSHKeyUpdateOpts{
+	if err := c.deleteReplaced(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDelReplaced)
+	}
+
+	keyUpToDate, err := publicKeyUpToDate(cr.Spec.ForProvider.PublicKey, cr.Status.AtProvider.Fingerprint)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFingerprint)
+	}
+	if !keyUpToDate {
+		return c.rotate(ctx, cr)
+	}
+
+	_, _, err = c.service.client.SSHKey.Update(ctx, &hcloud.SSHKey{ID: cr.Status.AtProvider.Id}, hcloud.SSHKeyUpdateOpts{
 		Name:   meta.GetExternalName(cr),
 		Labels: cr.Spec.ForProvider.Labels,
 	})
@@ -200,12 +252,81 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}, err
 }
 
+// rotate replaces the SSHKey with one for the desired public key. Names of
+// SSHKeys are unique, so the old one is renamed to make way for the new one
+// and only deleted once the new one exists. The old one is recorded as
+// replaced as soon as it was renamed, so that it is deleted by a later Update
+// if anything fails in between.
+func (c *external) rotate(ctx context.Context, cr *v1alpha1.SSHKey) (managed.ExternalUpdate, error) {
+	name := meta.GetExternalName(cr)
+	old := &hcloud.SSHKey{ID: cr.Status.AtProvider.Id}
+	if _, _, err := c.service.client.SSHKey.Update(ctx, old, hcloud.SSHKeyUpdateOpts{
+		Name: fmt.Sprintf("%s-%d", name, old.ID),
+	}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotate)
+	}
+	cr.Status.AtProvider.ReplacedID = old.ID
+
+	key, _, err := c.service.client.SSHKey.Create(ctx, hcloud.SSHKeyCreateOpts{
+		Name:      name,
+		PublicKey: cr.Spec.ForProvider.PublicKey,
+		Labels:    cr.Spec.ForProvider.Labels,
+	})
+	if err != nil {
+		// The old key takes its name back so that it is observed again.
+		// Otherwise it stays recorded as replaced by the key Create makes.
+		if _, _, rerr := c.service.client.SSHKey.Update(ctx, old, hcloud.SSHKeyUpdateOpts{Name: name}); rerr != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(errors.Wrapf(rerr, "cannot rename SSHKey %d back after %v", old.ID, err), errRotate)
+		}
+		cr.Status.AtProvider.ReplacedID = 0
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotate)
+	}
+	c.recorder.Event(cr, event.Normal(reasonRotate, fmt.Sprintf("Created SSHKey %d with fingerprint %s to replace SSHKey %d with fingerprint %s",
+		key.ID, key.Fingerprint, old.ID, cr.Status.AtProvider.Fingerprint)))
+
+	cr.Status.AtProvider.Id = key.ID
+	cr.Status.AtProvider.Fingerprint = key.Fingerprint
+	cr.Status.AtProvider.PublicKey = key.PublicKey
+
+	if err := c.deleteReplaced(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDelReplaced)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			"publicKey":   []byte(key.PublicKey),
+			"fingerprint": []byte(key.Fingerprint),
+		},
+	}, nil
+}
+
+// deleteReplaced deletes the SSHKey a rotation replaced, if any. A replaced
+// SSHKey that no longer exists counts as deleted.
+func (c *external) deleteReplaced(ctx context.Context, cr *v1alpha1.SSHKey) error {
+	id := cr.Status.AtProvider.ReplacedID
+	if id == 0 || id == cr.Status.AtProvider.Id {
+		cr.Status.AtProvider.ReplacedID = 0
+		return nil
+	}
+
+	if _, err := c.service.client.SSHKey.Delete(ctx, &hcloud.SSHKey{ID: id}); err != nil && !hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
+		return errors.Wrapf(err, "SSHKey %d", id)
+	}
+	c.recorder.Event(cr, event.Normal(reasonRotate, fmt.Sprintf("Deleted replaced SSHKey %d", id)))
+	cr.Status.AtProvider.ReplacedID = 0
+	return nil
+}
+
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.SSHKey)
 	if !ok {
 		return errors.New(errNotSSHKey)
 	}
 
+	if err := c.deleteReplaced(ctx, cr); err != nil {
+		return errors.Wrap(err, errDelReplaced)
+	}
+
 	_, err := c.service.client.SSHKey.Delete(ctx, &hcloud.SSHKey{ID: cr.Status.AtProvider.Id})
 	return err
 }
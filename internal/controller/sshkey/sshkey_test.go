@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+// sshKeyService returns a service whose API updates the SSHKey with ID 1 and
+// answers deleting the SSHKey with ID 2 with the given status.
+func sshKeyService(t *testing.T, deleteStatus int) *HCloudService {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/ssh_keys/2" && deleteStatus == http.StatusNoContent {
+			w.WriteHeader(deleteStatus)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/ssh_keys/1":
+			_, _ = w.Write([]byte(`{"ssh_key": {"id": 1, "name": "key", "fingerprint": "b7:2f:30", "created": "2022-01-01T00:00:00+00:00"}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/ssh_keys/2":
+			w.WriteHeader(deleteStatus)
+			_, _ = w.Write([]byte(`{"error": {"code": "service_error", "message": "unavailable"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"code": "not_found", "message": "not found"}}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return &HCloudService{client: hcloud.NewClient(hcloud.WithEndpoint(srv.URL), hcloud.WithToken("token"))}
+}
+
+func TestUpdateReplaced(t *testing.T) {
+	cases := map[string]struct {
+		reason       string
+		deleteStatus int
+		replaced     int
+		wantErr      bool
+		wantReplaced int
+	}{
+		"Deleted": {
+			reason:       "A replaced SSHKey should no longer be recorded once it was deleted.",
+			deleteStatus: http.StatusNoContent,
+			replaced:     2,
+		},
+		"Gone": {
+			reason:       "A replaced SSHKey that no longer exists should count as deleted.",
+			deleteStatus: http.StatusNotFound,
+			replaced:     3,
+		},
+		"DeleteFailed": {
+			reason:       "A replaced SSHKey should stay recorded until deleting it succeeds.",
+			deleteStatus: http.StatusServiceUnavailable,
+			replaced:     2,
+			wantErr:      true,
+			wantReplaced: 2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.SSHKey{Status: v1alpha1.SSHKeyStatus{AtProvider: v1alpha1.SSHKeyObservation{Id: 1, ReplacedID: tc.replaced}}}
+			e := external{service: sshKeyService(t, tc.deleteStatus), recorder: event.NewNopRecorder()}
+			_, err := e.Update(context.Background(), cr)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("\n%s\ne.Update(...): want error %t, got %v\n", tc.reason, tc.wantErr, err)
+			}
+			if got := cr.Status.AtProvider.ReplacedID; got != tc.wantReplaced {
+				t.Errorf("\n%s\ne.Update(...): want replaced SSHKey %d, got %d\n", tc.reason, tc.wantReplaced, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package sshkey
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+const rsaKeyBits = 4096
+
+// keyPair is a generated SSH keypair.
+type keyPair struct {
+	// privateKey is PEM encoded, in OpenSSH format for ed25519 keys and in
+	// PKCS #1 format for RSA keys.
+	privateKey []byte
+	// publicKey is in OpenSSH authorized_keys format.
+	publicKey []byte
+}
+
+// generateKeyPair generates a keypair of the given type.
+func generateKeyPair(keyType string) (keyPair, error) {
+	switch keyType {
+	case "", v1alpha1.SSHKeyTypeED25519:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return keyPair{}, err
+		}
+		return toKeyPair(pub, &pem.Block{Type: "OPENSSH PRIVATE KEY", Bytes: marshalED25519(pub, priv)})
+	case v1alpha1.SSHKeyTypeRSA:
+		priv, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return keyPair{}, err
+		}
+		return toKeyPair(&priv.PublicKey, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv)})
+	}
+	return keyPair{}, errors.Errorf("unsupported key type %q", keyType)
+}
+
+func toKeyPair(pub interface{}, priv *pem.Block) (keyPair, error) {
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return keyPair{}, err
+	}
+	return keyPair{
+		privateKey: pem.EncodeToMemory(priv),
+		publicKey:  ssh.MarshalAuthorizedKey(sshPub),
+	}, nil
+}
+
+// marshalED25519 encodes an ed25519 private key in the unencrypted
+// openssh-key-v1 format, which is the only one OpenSSH reads ed25519 keys in.
+func marshalED25519(pub ed25519.PublicKey, priv ed25519.PrivateKey) []byte {
+	pubKey := struct {
+		KeyType string
+		Pub     []byte
+	}{ssh.KeyAlgoED25519, pub}
+
+	// The check bytes only detect wrong passphrases, which an unencrypted key
+	// has none of.
+	var check uint32
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err == nil {
+		check = binary.BigEndian.Uint32(b[:])
+	}
+	privKey := struct {
+		Check1  uint32
+		Check2  uint32
+		KeyType string
+		Pub     []byte
+		Priv    []byte
+		Comment string
+		Pad     []byte `ssh:"rest"`
+	}{Check1: check, Check2: check, KeyType: ssh.KeyAlgoED25519, Pub: pub, Priv: priv}
+
+	// The private section is padded to the cipher block size, 8 for none.
+	rest := len(ssh.Marshal(privKey)) % 8
+	for i := 1; rest != 0 && i <= 8-rest; i++ {
+		privKey.Pad = append(privKey.Pad, byte(i))
+	}
+
+	key := struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{"none", "none", "", 1, ssh.Marshal(pubKey), ssh.Marshal(privKey)}
+
+	return append([]byte("openssh-key-v1\x00"), ssh.Marshal(key)...)
+}
+
+// publicKeyUpToDate reports whether the desired public key has the given
+// fingerprint. Generated keys have no desired public key and are kept.
+func publicKeyUpToDate(publicKey, fingerprint string) (bool, error) {
+	if publicKey == "" {
+		return true, nil
+	}
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return false, err
+	}
+	return ssh.FingerprintLegacyMD5(pub) == fingerprint, nil
+}
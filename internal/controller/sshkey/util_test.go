@@ -0,0 +1,78 @@
+package sshkey
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestGenerateKeyPair(t *testing.T) {
+	cases := map[string]struct {
+		keyType string
+		want    string
+		wantErr bool
+	}{
+		"Default": {keyType: "", want: ssh.KeyAlgoED25519},
+		"ED25519": {keyType: v1alpha1.SSHKeyTypeED25519, want: ssh.KeyAlgoED25519},
+		"RSA":     {keyType: v1alpha1.SSHKeyTypeRSA, want: ssh.KeyAlgoRSA},
+		"Unknown": {keyType: "dsa", wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pair, err := generateKeyPair(tc.keyType)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("generateKeyPair(...): want error %t, got %v", tc.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			signer, err := ssh.ParsePrivateKey(pair.privateKey)
+			if err != nil {
+				t.Fatalf("ssh.ParsePrivateKey(...): unexpected error: %v", err)
+			}
+			pub, _, _, _, err := ssh.ParseAuthorizedKey(pair.publicKey)
+			if err != nil {
+				t.Fatalf("ssh.ParseAuthorizedKey(...): unexpected error: %v", err)
+			}
+			if pub.Type() != tc.want {
+				t.Errorf("generateKeyPair(...): want %s key, got %s", tc.want, pub.Type())
+			}
+			if string(signer.PublicKey().Marshal()) != string(pub.Marshal()) {
+				t.Errorf("generateKeyPair(...): private key does not match public key")
+			}
+		})
+	}
+}
+
+func TestPublicKeyUpToDate(t *testing.T) {
+	publicKey := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC4CZ3YnS9LMBQoFhzoG/jwf/GU6j93JXSqSpuz0w8Zyt1+50bVbCivMvnfo/VPoyeCnZMfDuw5LYsAqAPUE/eODZY8hrJC4GUVgaYfWnHe8iMNKHqduN4dvwmK3M2ddkqMoT0wqINYFJiOtDgFIpRNtIuN1UKGDX2NBG5v0fJHJ6kesMgzu9ywOvaShoQoFgxEs+VitXFiYA62+4CJ7Xb8YAZ+SnA7iv2MDkrWPKZRxE/NYU1BhQAOKaldhfwI+ZmD6OQlPD2sbom5qye9deQL6hI3z/Wh/xQP0dUF17DAqVVxChvKxNF1/leDg3MqPkQSGNeB8BJWpGk14+Wc20wJ user@example.com"
+	fingerprint := "f9:8d:e3:4a:1a:5a:62:41:52:88:0d:09:54:46:20:b3"
+
+	cases := map[string]struct {
+		publicKey   string
+		fingerprint string
+		want        bool
+		wantErr     bool
+	}{
+		"Generated": {publicKey: "", fingerprint: fingerprint, want: true},
+		"Same":      {publicKey: publicKey, fingerprint: fingerprint, want: true},
+		"Rotated":   {publicKey: publicKey, fingerprint: "00:11:22:33:44:55:66:77:88:99:aa:bb:cc:dd:ee:ff", want: false},
+		"Invalid":   {publicKey: "ssh-rsa invalid", fingerprint: fingerprint, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := publicKeyUpToDate(tc.publicKey, tc.fingerprint)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("publicKeyUpToDate(...): want error %t, got %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("publicKeyUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnet
+
+import (
+	"context"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	"k8s.io/apimachinery/pkg/types"
+	"net"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/yaskoo/provider-hetzner/apis/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/features"
+)
+
+const (
+	errNotSubnet    = "managed resource is not a Subnet custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errToSubnet      = "cannot build Subnet from spec"
+	errDeleteSubnet  = "cannot delete Subnet"
+	errAddSubnet     = "cannot add Subnet"
+	errRestoreSubnet = "cannot restore replaced Subnet"
+	errNoNetwork     = "Network does not exist"
+)
+
+// A HCloudService is the interface to the Hetzner cloud API.
+type HCloudService struct {
+	client *hcloud.Client
+}
+
+var (
+	hCloudService = func(creds []byte) (*HCloudService, error) {
+		return &HCloudService{
+			client: hcloud.NewClient(hcloud.WithToken(string(creds))),
+		}, nil
+	}
+)
+
+// Setup adds a controller that reconciles Subnet managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SubnetGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SubnetGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: hCloudService}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Subnet{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (*HCloudService, error)
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Subnet)
+	if !ok {
+		return nil, errors.New(errNotSubnet)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service *HCloudService
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Subnet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSubnet)
+	}
+
+	network, _, err := c.service.client.Network.GetByID(ctx, cr.Spec.ForProvider.Network)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if network == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// A Subnet is identified by its IP range. Look for the one applied last,
+	// so that a changed range replaces it rather than leaving it behind.
+	ipRange := cr.Status.AtProvider.IPRange
+	if ipRange == "" {
+		ipRange = cr.Spec.ForProvider.IPRange
+	}
+
+	subnet := findSubnet(network.Subnets, ipRange)
+	if subnet == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.IPRange = subnet.IPRange.String()
+	cr.Status.AtProvider.Gateway = subnet.Gateway.String()
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  subnetUpToDate(cr.Spec.ForProvider, subnet),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Subnet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSubnet)
+	}
+
+	subnet, err := toNetworkSubnet(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errToSubnet)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, c.addSubnet(ctx, &hcloud.Network{ID: cr.Spec.ForProvider.Network}, subnet)
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Subnet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSubnet)
+	}
+
+	subnet, err := toNetworkSubnet(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errToSubnet)
+	}
+
+	network, _, err := c.service.client.Network.GetByID(ctx, cr.Spec.ForProvider.Network)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if network == nil {
+		return managed.ExternalUpdate{}, errors.New(errNoNetwork)
+	}
+
+	// Subnets cannot be changed in place, so the applied one is replaced.
+	if applied := findSubnet(network.Subnets, cr.Status.AtProvider.IPRange); applied != nil {
+		if err := c.replaceSubnet(ctx, network, *applied, subnet); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	} else if err := c.addSubnet(ctx, network, subnet); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAddSubnet)
+	}
+
+	cr.Status.AtProvider.IPRange = subnet.IPRange.String()
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Subnet)
+	if !ok {
+		return errors.New(errNotSubnet)
+	}
+
+	_, ipRange, err := net.ParseCIDR(cr.Status.AtProvider.IPRange)
+	if err != nil {
+		return err
+	}
+
+	return c.deleteSubnet(ctx, &hcloud.Network{ID: cr.Spec.ForProvider.Network}, hcloud.NetworkSubnet{IPRange: ipRange})
+}
+
+// replaceSubnet replaces the applied Subnet so that a failure leaves it in
+// place. A Subnet that does not overlap the applied one is added before the
+// applied one is deleted. Overlapping Subnets cannot coexist, so the applied
+// one is deleted first and added back if its replacement cannot be added.
+func (c *external) replaceSubnet(ctx context.Context, network *hcloud.Network, applied, subnet hcloud.NetworkSubnet) error {
+	if !overlaps(applied.IPRange, subnet.IPRange) {
+		if !hasSubnet(network.Subnets, subnet) {
+			if err := c.addSubnet(ctx, network, subnet); err != nil {
+				return errors.Wrap(err, errAddSubnet)
+			}
+		}
+		return errors.Wrap(c.deleteSubnet(ctx, network, applied), errDeleteSubnet)
+	}
+
+	if err := c.deleteSubnet(ctx, network, applied); err != nil {
+		return errors.Wrap(err, errDeleteSubnet)
+	}
+	if err := c.addSubnet(ctx, network, subnet); err != nil {
+		if err := c.addSubnet(ctx, network, applied); err != nil {
+			return errors.Wrap(err, errRestoreSubnet)
+		}
+		return errors.Wrap(err, errAddSubnet)
+	}
+	return nil
+}
+
+func (c *external) addSubnet(ctx context.Context, network *hcloud.Network, subnet hcloud.NetworkSubnet) error {
+	action, _, err := c.service.client.Network.AddSubnet(ctx, network, hcloud.NetworkAddSubnetOpts{
+		Subnet: subnet,
+	})
+	if err != nil {
+		return err
+	}
+	return util.WaitForAction(ctx, c.service.client, action)
+}
+
+func (c *external) deleteSubnet(ctx context.Context, network *hcloud.Network, subnet hcloud.NetworkSubnet) error {
+	action, _, err := c.service.client.Network.DeleteSubnet(ctx, network, hcloud.NetworkDeleteSubnetOpts{
+		Subnet: subnet,
+	})
+	if err != nil {
+		return err
+	}
+	return util.WaitForAction(ctx, c.service.client, action)
+}
@@ -0,0 +1,58 @@
+package subnet
+
+import (
+	"net"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+)
+
+func toNetworkSubnet(sp v1alpha1.SubnetParameters) (hcloud.NetworkSubnet, error) {
+	_, ipRange, err := net.ParseCIDR(sp.IPRange)
+	if err != nil {
+		return hcloud.NetworkSubnet{}, err
+	}
+
+	subnet := hcloud.NetworkSubnet{
+		Type:        hcloud.NetworkSubnetType(sp.Type),
+		IPRange:     ipRange,
+		NetworkZone: hcloud.NetworkZone(sp.NetworkZone),
+	}
+
+	if sp.VSwitchID != nil {
+		subnet.VSwitchID = *sp.VSwitchID
+	}
+	return subnet, nil
+}
+
+func findSubnet(subnets []hcloud.NetworkSubnet, ipRange string) *hcloud.NetworkSubnet {
+	for i := range subnets {
+		if util.IPRangeUpToDate(ipRange, subnets[i].IPRange) {
+			return &subnets[i]
+		}
+	}
+	return nil
+}
+
+func subnetUpToDate(sp v1alpha1.SubnetParameters, subnet *hcloud.NetworkSubnet) bool {
+	vSwitchID := 0
+	if sp.VSwitchID != nil {
+		vSwitchID = *sp.VSwitchID
+	}
+
+	return util.IPRangeUpToDate(sp.IPRange, subnet.IPRange) &&
+		sp.Type == string(subnet.Type) &&
+		sp.NetworkZone == string(subnet.NetworkZone) &&
+		vSwitchID == subnet.VSwitchID
+}
+
+// hasSubnet reports whether the subnet has been applied already.
+func hasSubnet(subnets []hcloud.NetworkSubnet, subnet hcloud.NetworkSubnet) bool {
+	s := findSubnet(subnets, subnet.IPRange.String())
+	return s != nil && s.Type == subnet.Type && s.NetworkZone == subnet.NetworkZone && s.VSwitchID == subnet.VSwitchID
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
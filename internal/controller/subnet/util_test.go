@@ -0,0 +1,136 @@
+package subnet
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestSubnetUpToDate(t *testing.T) {
+	_, ipRange, _ := net.ParseCIDR("10.0.1.0/24")
+	vSwitchID := 1000
+
+	subnet := &hcloud.NetworkSubnet{
+		Type:        hcloud.NetworkSubnetTypeCloud,
+		IPRange:     ipRange,
+		NetworkZone: hcloud.NetworkZoneEUCentral,
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.SubnetParameters
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "A Subnet matching the spec should be up to date.",
+			params: v1alpha1.SubnetParameters{Type: "cloud", NetworkZone: "eu-central", IPRange: "10.0.1.0/24"},
+			want:   true,
+		},
+		"HostBitsSet": {
+			reason: "IP ranges should be compared by the network they denote.",
+			params: v1alpha1.SubnetParameters{Type: "cloud", NetworkZone: "eu-central", IPRange: "10.0.1.1/24"},
+			want:   true,
+		},
+		"DifferentRange": {
+			reason: "A Subnet with another IP range should not be up to date.",
+			params: v1alpha1.SubnetParameters{Type: "cloud", NetworkZone: "eu-central", IPRange: "10.0.2.0/24"},
+		},
+		"DifferentType": {
+			reason: "A Subnet of another type should not be up to date.",
+			params: v1alpha1.SubnetParameters{Type: "server", NetworkZone: "eu-central", IPRange: "10.0.1.0/24"},
+		},
+		"DifferentVSwitch": {
+			reason: "A Subnet connected to another vSwitch should not be up to date.",
+			params: v1alpha1.SubnetParameters{Type: "cloud", NetworkZone: "eu-central", IPRange: "10.0.1.0/24", VSwitchID: &vSwitchID},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := subnetUpToDate(tc.params, subnet); got != tc.want {
+				t.Errorf("\n%s\nsubnetUpToDate(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestHasSubnet(t *testing.T) {
+	_, ipRange, _ := net.ParseCIDR("10.0.1.0/24")
+	_, other, _ := net.ParseCIDR("10.0.2.0/24")
+
+	subnets := []hcloud.NetworkSubnet{{
+		Type:        hcloud.NetworkSubnetTypeCloud,
+		IPRange:     ipRange,
+		NetworkZone: hcloud.NetworkZoneEUCentral,
+	}}
+
+	cases := map[string]struct {
+		reason string
+		subnet hcloud.NetworkSubnet
+		want   bool
+	}{
+		"Applied": {
+			reason: "A Subnet that is part of the Network should be found.",
+			subnet: hcloud.NetworkSubnet{Type: hcloud.NetworkSubnetTypeCloud, IPRange: ipRange, NetworkZone: hcloud.NetworkZoneEUCentral},
+			want:   true,
+		},
+		"DifferentType": {
+			reason: "A Subnet with the same IP range but of another type has not been applied.",
+			subnet: hcloud.NetworkSubnet{Type: hcloud.NetworkSubnetTypeServer, IPRange: ipRange, NetworkZone: hcloud.NetworkZoneEUCentral},
+		},
+		"Missing": {
+			reason: "A Subnet with another IP range has not been applied.",
+			subnet: hcloud.NetworkSubnet{Type: hcloud.NetworkSubnetTypeCloud, IPRange: other, NetworkZone: hcloud.NetworkZoneEUCentral},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := hasSubnet(subnets, tc.subnet); got != tc.want {
+				t.Errorf("\n%s\nhasSubnet(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	_, applied, _ := net.ParseCIDR("10.0.1.0/24")
+
+	cases := map[string]struct {
+		reason  string
+		ipRange string
+		want    bool
+	}{
+		"Same": {
+			reason:  "A Subnet with the same IP range overlaps.",
+			ipRange: "10.0.1.0/24",
+			want:    true,
+		},
+		"Narrower": {
+			reason:  "A Subnet within the applied IP range overlaps.",
+			ipRange: "10.0.1.128/25",
+			want:    true,
+		},
+		"Wider": {
+			reason:  "A Subnet containing the applied IP range overlaps.",
+			ipRange: "10.0.0.0/16",
+			want:    true,
+		},
+		"Disjoint": {
+			reason:  "A Subnet next to the applied IP range does not overlap.",
+			ipRange: "10.0.2.0/24",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ipRange, _ := net.ParseCIDR(tc.ipRange)
+			if got := overlaps(applied, ipRange); got != tc.want {
+				t.Errorf("\n%s\noverlaps(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
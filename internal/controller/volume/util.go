@@ -0,0 +1,45 @@
+package volume
+
+import (
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func toVolumeCreateOpts(name string, vp v1alpha1.VolumeParameters) hcloud.VolumeCreateOpts {
+	opts := hcloud.VolumeCreateOpts{
+		Name:   name,
+		Size:   vp.Size,
+		Labels: vp.Labels,
+		Format: vp.Format,
+	}
+
+	// Location and automount are mutually exclusive with attaching the
+	// Volume right away, as it is then created in the Location of the Server.
+	if vp.Server != nil {
+		opts.Server = &hcloud.Server{
+			ID: *vp.Server,
+		}
+		opts.Automount = vp.Automount
+	} else if vp.Location != nil {
+		opts.Location = &hcloud.Location{
+			ID:   int(vp.Location.IntVal),
+			Name: vp.Location.StrVal,
+		}
+	}
+	return opts
+}
+
+func serverID(vol *hcloud.Volume) *int {
+	if vol.Server == nil {
+		return nil
+	}
+	id := vol.Server.ID
+	return &id
+}
+
+func serverUpToDate(desired, actual *int) bool {
+	if desired == nil || actual == nil {
+		return desired == nil && actual == nil
+	}
+	return *desired == *actual
+}
@@ -0,0 +1,85 @@
+package volume
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+)
+
+func TestToVolumeCreateOpts(t *testing.T) {
+	server := 42
+	automount := true
+	location := intstr.FromString("nbg1")
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.VolumeParameters
+		want   hcloud.VolumeCreateOpts
+	}{
+		"InLocation": {
+			reason: "A detached Volume should be created in the given Location.",
+			params: v1alpha1.VolumeParameters{
+				Size:      10,
+				Location:  &location,
+				Automount: &automount,
+			},
+			want: hcloud.VolumeCreateOpts{
+				Name:     "vol",
+				Size:     10,
+				Location: &hcloud.Location{Name: "nbg1"},
+			},
+		},
+		"AttachedToServer": {
+			reason: "An attached Volume should be created next to its Server, ignoring the Location.",
+			params: v1alpha1.VolumeParameters{
+				Size:      20,
+				Location:  &location,
+				Automount: &automount,
+				Server:    &server,
+			},
+			want: hcloud.VolumeCreateOpts{
+				Name:      "vol",
+				Size:      20,
+				Server:    &hcloud.Server{ID: server},
+				Automount: &automount,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := toVolumeCreateOpts("vol", tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntoVolumeCreateOpts(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestServerUpToDate(t *testing.T) {
+	one, two := 1, 2
+
+	cases := map[string]struct {
+		desired *int
+		actual  *int
+		want    bool
+	}{
+		"BothDetached":    {want: true},
+		"ShouldAttach":    {desired: &one},
+		"ShouldDetach":    {actual: &one},
+		"SameServer":      {desired: &one, actual: &one, want: true},
+		"DifferentServer": {desired: &one, actual: &two},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := serverUpToDate(tc.desired, tc.actual); got != tc.want {
+				t.Errorf("serverUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
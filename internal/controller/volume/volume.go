@@ -0,0 +1,313 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"github.com/pkg/errors"
+	"github.com/yaskoo/provider-hetzner/internal/controller/common/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/yaskoo/provider-hetzner/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/yaskoo/provider-hetzner/apis/v1alpha1"
+	"github.com/yaskoo/provider-hetzner/internal/controller/features"
+)
+
+const (
+	errNotVolume    = "managed resource is not a Volume custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errResize = "cannot resize Volume"
+	errDetach = "cannot detach Volume"
+	errAttach = "cannot attach Volume"
+
+	errChangeProtection = "cannot change Volume protection"
+)
+
+// A HCloudService is the interface to the Hetzner cloud API.
+type HCloudService struct {
+	client *hcloud.Client
+}
+
+var (
+	hCloudService = func(creds []byte) (*HCloudService, error) {
+		return &HCloudService{
+			client: hcloud.NewClient(hcloud.WithToken(string(creds))),
+		}, nil
+	}
+)
+
+// Setup adds a controller that reconciles Volume managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.VolumeGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.VolumeGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: hCloudService}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Volume{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (*HCloudService, error)
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Volume)
+	if !ok {
+		return nil, errors.New(errNotVolume)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service *HCloudService
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Volume)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotVolume)
+	}
+
+	vol, _, err := c.service.client.Volume.GetByName(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	exists := vol != nil && vol.ID > 0
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.Id = vol.ID
+	cr.Status.AtProvider.Created = &metav1.Time{Time: vol.Created}
+	cr.Status.AtProvider.Status = string(vol.Status)
+	cr.Status.AtProvider.Size = vol.Size
+	cr.Status.AtProvider.LinuxDevice = vol.LinuxDevice
+	cr.Status.AtProvider.Server = serverID(vol)
+	if vol.Location != nil {
+		cr.Status.AtProvider.Location = vol.Location.Name
+	}
+
+	if vol.Status == hcloud.VolumeStatusAvailable {
+		cr.Status.SetConditions(xpv1.Available())
+	} else {
+		cr.Status.SetConditions(xpv1.Creating())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: util.LabelsUpToDate(cr.Spec.ForProvider.Labels, vol.Labels) &&
+			vol.Size >= cr.Spec.ForProvider.Size &&
+			serverUpToDate(cr.Spec.ForProvider.Server, serverID(vol)) &&
+			util.ProtectionUpToDate(cr.Spec.ForProvider.Protection.GetDelete(), vol.Protection.Delete),
+		ConnectionDetails: managed.ConnectionDetails{
+			"linuxDevice": []byte(vol.LinuxDevice),
+		},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Volume)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotVolume)
+	}
+
+	_, _, err := c.service.client.Volume.Create(ctx, toVolumeCreateOpts(meta.GetExternalName(cr), cr.Spec.ForProvider))
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, err
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Volume)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotVolume)
+	}
+
+	vol := &hcloud.Volume{
+		ID: cr.Status.AtProvider.Id,
+	}
+
+	labels := cr.Spec.ForProvider.Labels
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	if _, _, err := c.service.client.Volume.Update(ctx, vol, hcloud.VolumeUpdateOpts{
+		Labels: labels,
+	}); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if protect := cr.Spec.ForProvider.Protection.GetDelete(); protect != nil {
+		action, _, err := c.service.client.Volume.ChangeProtection(ctx, vol, hcloud.VolumeChangeProtectionOpts{
+			Delete: protect,
+		})
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errChangeProtection)
+		}
+	}
+
+	if cr.Spec.ForProvider.Size > cr.Status.AtProvider.Size {
+		action, _, err := c.service.client.Volume.Resize(ctx, vol, cr.Spec.ForProvider.Size)
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errResize)
+		}
+	}
+
+	if serverUpToDate(cr.Spec.ForProvider.Server, cr.Status.AtProvider.Server) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if cr.Status.AtProvider.Server != nil {
+		action, _, err := c.service.client.Volume.Detach(ctx, vol)
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDetach)
+		}
+	}
+
+	if cr.Spec.ForProvider.Server != nil {
+		action, _, err := c.service.client.Volume.AttachWithOpts(ctx, vol, hcloud.VolumeAttachOpts{
+			Server:    &hcloud.Server{ID: *cr.Spec.ForProvider.Server},
+			Automount: cr.Spec.ForProvider.Automount,
+		})
+		if err == nil {
+			err = util.WaitForAction(ctx, c.service.client, action)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttach)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Volume)
+	if !ok {
+		return errors.New(errNotVolume)
+	}
+
+	vol := &hcloud.Volume{
+		ID: cr.Status.AtProvider.Id,
+	}
+
+	attached := cr.Status.AtProvider.Server != nil
+	return util.DeleteUnlessProtected(cr, cr.Spec.ForProvider.Protection.GetDelete(), func() error {
+		// Attached volumes cannot be deleted.
+		if attached {
+			action, _, err := c.service.client.Volume.Detach(ctx, vol)
+			if err == nil {
+				err = util.WaitForAction(ctx, c.service.client, action)
+			}
+			if err != nil {
+				return errors.Wrap(err, errDetach)
+			}
+			attached = false
+		}
+
+		_, err := c.service.client.Volume.Delete(ctx, vol)
+		return err
+	}, func() error {
+		action, _, err := c.service.client.Volume.ChangeProtection(ctx, vol, hcloud.VolumeChangeProtectionOpts{
+			Delete: hcloud.Ptr(false),
+		})
+		if err != nil {
+			return err
+		}
+		return util.WaitForAction(ctx, c.service.client, action)
+	})
+}